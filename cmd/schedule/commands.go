@@ -0,0 +1,185 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"gopkg.in/yaml.v3"
+	"net"
+	"net/http"
+	"os"
+	"schedule/internal/app"
+	"schedule/internal/config"
+	"schedule/internal/infrastructure/persistence/migrate"
+	"schedule/internal/server/httpserver"
+	"text/tabwriter"
+	"time"
+)
+
+const healthcheckTimeout = 3 * time.Second
+
+func runMigrate(cfg *config.Config, args []string) error {
+	if len(args) != 1 {
+		return errors.New("expected up, down or status")
+	}
+
+	db, err := app.ConnectDB(cfg)
+	if err != nil {
+		return err
+	}
+	if db == nil {
+		return fmt.Errorf("driver '%s' has no migrations", cfg.Database.Driver)
+	}
+	defer db.Close()
+
+	ctx := context.Background()
+
+	switch args[0] {
+	case "up":
+		results, err := migrate.UpLocked(ctx, db.DB, cfg.Database.Driver, cfg.Database.MigrateLockTimeout)
+		if err != nil {
+			return err
+		}
+		for _, result := range results {
+			fmt.Println(result)
+		}
+		fmt.Printf("applied %d migrations\n", len(results))
+
+	case "down":
+		result, err := migrate.Down(ctx, db.DB, cfg.Database.Driver)
+		if err != nil {
+			return err
+		}
+		fmt.Println(result)
+
+	case "status":
+		statuses, err := migrate.Status(ctx, db.DB, cfg.Database.Driver)
+		if err != nil {
+			return err
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "VERSION\tSTATE\tAPPLIED AT\tSOURCE")
+		for _, status := range statuses {
+			appliedAt := "-"
+			if !status.AppliedAt.IsZero() {
+				appliedAt = status.AppliedAt.Format(time.DateTime)
+			}
+			fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", status.Source.Version, status.State, appliedAt, status.Source.Path)
+		}
+		return w.Flush()
+
+	default:
+		return fmt.Errorf("unknown migrate command '%s'", args[0])
+	}
+
+	return nil
+}
+
+func runConfig(cfg *config.Config, args []string) error {
+	if len(args) != 1 || args[0] != "print" {
+		return errors.New("expected print")
+	}
+
+	hidden := *cfg
+	hidden.MySQl.Password = hideSecret(hidden.MySQl.Password)
+	hidden.Postgres.Password = hideSecret(hidden.Postgres.Password)
+
+	enc := yaml.NewEncoder(os.Stdout)
+	enc.SetIndent(2)
+	if err := enc.Encode(hidden); err != nil {
+		return err
+	}
+	return enc.Close()
+}
+
+func hideSecret(s string) string {
+	if s == "" {
+		return ""
+	}
+	return "hidden"
+}
+
+// runHealthcheck is used as docker HEALTHCHECK, image has no shell or curl.
+// Servers with mutual tls are only checked to accept connections, healthcheck has no client certificate.
+func runHealthcheck(cfg *config.Config) error {
+	ctx, cancel := context.WithTimeout(context.Background(), healthcheckTimeout)
+	defer cancel()
+
+	if err := checkHttpHealth(ctx, cfg.HttpServer); err != nil {
+		return fmt.Errorf("http server: %w", err)
+	}
+	if err := checkGrpcHealth(ctx, cfg.GrpcServer); err != nil {
+		return fmt.Errorf("grpc server: %w", err)
+	}
+
+	fmt.Println("ok")
+	return nil
+}
+
+func checkHttpHealth(ctx context.Context, cfg config.HttpServerConfig) error {
+	if cfg.TLS.ClientCAFile != "" {
+		return dial(ctx, cfg.Addr)
+	}
+
+	client, scheme := http.DefaultClient, "http://"
+	if cfg.TLS.Enabled() {
+		client = &http.Client{Transport: &http.Transport{TLSClientConfig: healthcheckTLSConfig()}}
+		scheme = "https://"
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, scheme+cfg.Addr+httpserver.HealthzPath, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return errors.New(resp.Status)
+	}
+
+	return nil
+}
+
+func checkGrpcHealth(ctx context.Context, cfg config.GrpcServerConfig) error {
+	if cfg.TLS.ClientCAFile != "" {
+		return dial(ctx, cfg.Addr)
+	}
+
+	creds := insecure.NewCredentials()
+	if cfg.TLS.Enabled() {
+		creds = credentials.NewTLS(healthcheckTLSConfig())
+	}
+
+	conn, err := grpc.NewClient("passthrough:///"+cfg.Addr, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	// empty service is liveness, it stays serving while database is unavailable
+	_, err = healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{})
+	return err
+}
+
+// healthcheckTLSConfig does not verify server certificate, healthcheck runs next to server and its address may be not in certificate.
+func healthcheckTLSConfig() *tls.Config {
+	return &tls.Config{InsecureSkipVerify: true} //nolint:gosec
+}
+
+func dial(ctx context.Context, addr string) error {
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
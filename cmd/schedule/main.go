@@ -1,22 +1,70 @@
 package main
 
 import (
+	"flag"
+	"fmt"
 	"log"
 	"os"
 	"schedule/internal/app"
 	"schedule/internal/config"
 )
 
+const usage = `usage: schedule [-config path] [command]
+
+commands:
+  serve                     run http and grpc servers (default)
+  migrate up|down|status    apply, roll back last or show database migrations
+  config print              print config with hidden secrets
+  healthcheck               check that servers accept connections
+`
+
 func main() {
-	configPath := "config/config.yaml"
-	if len(os.Args) > 1 {
-		configPath = os.Args[1]
+	configPath := flag.String("config", "config/config.yaml", "config file path")
+	flag.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), usage)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	args := flag.Args()
+	if len(args) == 1 && !isCommand(args[0]) { // old style: schedule <config path>
+		*configPath = args[0]
+		args = nil
 	}
 
-	cfg, err := config.ReadConfig(configPath)
+	cfg, err := config.ReadConfig(*configPath)
 	if err != nil {
 		log.Fatal("read config error: ", err)
 	}
 
-	app.Run(cfg)
+	command := "serve"
+	if len(args) > 0 {
+		command, args = args[0], args[1:]
+	}
+
+	switch command {
+	case "serve":
+		err = app.Run(cfg)
+	case "migrate":
+		err = runMigrate(cfg, args)
+	case "config":
+		err = runConfig(cfg, args)
+	case "healthcheck":
+		err = runHealthcheck(cfg)
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if err != nil {
+		log.Fatal(command, " error: ", err)
+	}
+}
+
+func isCommand(s string) bool {
+	switch s {
+	case "serve", "migrate", "config", "healthcheck":
+		return true
+	}
+	return false
 }
@@ -0,0 +1,15 @@
+// Package db contains database migrations embedded into binary.
+package db
+
+import (
+	"embed"
+	"io/fs"
+)
+
+//go:embed migrations
+var migrations embed.FS
+
+// Migrations returns goose migrations of driver (mysql, postgres or sqlite).
+func Migrations(driver string) (fs.FS, error) {
+	return fs.Sub(migrations, "migrations/"+driver)
+}
@@ -0,0 +1,35 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+CREATE TABLE schedule_audit (
+    id           bigint auto_increment primary key,
+    schedule_id  int          not null,
+    user_id      bigint       not null,
+    actor        varchar(255) not null,
+    action       varchar(16)  not null,
+    before_state json         null,
+    after_state  json         null,
+    trace_id     varchar(64)  not null,
+    created_at   datetime(6)  not null,
+    INDEX schedule_id_idx USING BTREE (user_id, schedule_id)
+);
+
+-- append-only
+-- +goose StatementBegin
+CREATE TRIGGER schedule_audit_no_update BEFORE UPDATE ON schedule_audit FOR EACH ROW
+    SIGNAL SQLSTATE '45000' SET MESSAGE_TEXT = 'schedule_audit is append-only';
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER schedule_audit_no_delete BEFORE DELETE ON schedule_audit FOR EACH ROW
+    SIGNAL SQLSTATE '45000' SET MESSAGE_TEXT = 'schedule_audit is append-only';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
+
+DROP TABLE schedule_audit;
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+ALTER TABLE schedule
+    ADD COLUMN round_the_clock boolean     not null default false,
+    ADD COLUMN anchor_at       datetime(6) null;
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
+
+ALTER TABLE schedule
+    DROP COLUMN round_the_clock,
+    DROP COLUMN anchor_at;
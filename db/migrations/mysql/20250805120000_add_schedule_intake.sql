@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+SELECT 'up SQL query';
+-- +goose StatementEnd
+
+CREATE TABLE schedule_intake (
+    id          bigint auto_increment primary key,
+    schedule_id int          not null,
+    user_id     bigint       not null,
+    name        varchar(255) not null,
+    taken_at    datetime(6)  not null,
+    INDEX user_id_idx USING BTREE (user_id, taken_at)
+);
+
+-- +goose Down
+-- +goose StatementBegin
+SELECT 'down SQL query';
+-- +goose StatementEnd
+
+DROP TABLE schedule_intake;
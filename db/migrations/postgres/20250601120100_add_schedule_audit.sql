@@ -0,0 +1,30 @@
+-- +goose Up
+CREATE TABLE schedule_audit (
+    id           bigserial primary key,
+    schedule_id  int          not null,
+    user_id      bigint       not null,
+    actor        varchar(255) not null,
+    action       varchar(16)  not null,
+    before_state jsonb        null,
+    after_state  jsonb        null,
+    trace_id     varchar(64)  not null,
+    created_at   timestamptz  not null
+);
+
+CREATE INDEX schedule_audit_schedule_id_idx ON schedule_audit (user_id, schedule_id);
+
+-- append-only
+-- +goose StatementBegin
+CREATE FUNCTION schedule_audit_append_only() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'schedule_audit is append-only';
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE TRIGGER schedule_audit_no_change BEFORE UPDATE OR DELETE ON schedule_audit
+    FOR EACH ROW EXECUTE FUNCTION schedule_audit_append_only();
+
+-- +goose Down
+DROP TABLE schedule_audit;
+DROP FUNCTION schedule_audit_append_only();
@@ -0,0 +1,8 @@
+-- +goose Up
+CREATE TABLE user_settings (
+    user_id               bigint  not null primary key,
+    consolidate_reminders boolean not null default false
+);
+
+-- +goose Down
+DROP TABLE user_settings;
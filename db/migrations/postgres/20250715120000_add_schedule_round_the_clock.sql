@@ -0,0 +1,9 @@
+-- +goose Up
+ALTER TABLE schedule
+    ADD COLUMN round_the_clock boolean     not null default false,
+    ADD COLUMN anchor_at       timestamptz null;
+
+-- +goose Down
+ALTER TABLE schedule
+    DROP COLUMN round_the_clock,
+    DROP COLUMN anchor_at;
@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE schedule
+    ADD COLUMN final_dose_at timestamptz null;
+
+-- +goose Down
+ALTER TABLE schedule
+    DROP COLUMN final_dose_at;
@@ -0,0 +1,13 @@
+-- +goose Up
+CREATE TABLE schedule_intake (
+    id          bigserial primary key,
+    schedule_id int          not null,
+    user_id     bigint       not null,
+    name        varchar(255) not null,
+    taken_at    timestamptz  not null
+);
+
+CREATE INDEX schedule_intake_user_id_idx ON schedule_intake (user_id, taken_at);
+
+-- +goose Down
+DROP TABLE schedule_intake;
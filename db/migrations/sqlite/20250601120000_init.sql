@@ -0,0 +1,13 @@
+-- +goose Up
+CREATE TABLE schedule (
+    id      integer primary key autoincrement,
+    user_id bigint       not null,
+    name    varchar(255) not null collate nocase, -- case-insensitive as in mysql
+    end_at  date         null,
+    period  bigint       not null
+);
+
+CREATE INDEX user_id_idx ON schedule (user_id);
+
+-- +goose Down
+DROP TABLE schedule;
@@ -0,0 +1,32 @@
+-- +goose Up
+CREATE TABLE schedule_audit (
+    id           integer primary key autoincrement,
+    schedule_id  int          not null,
+    user_id      bigint       not null,
+    actor        varchar(255) not null,
+    action       varchar(16)  not null,
+    before_state text         null,
+    after_state  text         null,
+    trace_id     varchar(64)  not null,
+    created_at   datetime     not null
+);
+
+CREATE INDEX schedule_audit_schedule_id_idx ON schedule_audit (user_id, schedule_id);
+
+-- append-only
+-- +goose StatementBegin
+CREATE TRIGGER schedule_audit_no_update BEFORE UPDATE ON schedule_audit
+BEGIN
+    SELECT RAISE(ABORT, 'schedule_audit is append-only');
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER schedule_audit_no_delete BEFORE DELETE ON schedule_audit
+BEGIN
+    SELECT RAISE(ABORT, 'schedule_audit is append-only');
+END;
+-- +goose StatementEnd
+
+-- +goose Down
+DROP TABLE schedule_audit;
@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE schedule ADD COLUMN round_the_clock boolean not null default false;
+ALTER TABLE schedule ADD COLUMN anchor_at datetime null;
+
+-- +goose Down
+ALTER TABLE schedule DROP COLUMN round_the_clock;
+ALTER TABLE schedule DROP COLUMN anchor_at;
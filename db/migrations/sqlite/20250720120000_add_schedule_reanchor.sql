@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE schedule ADD COLUMN reanchor boolean not null default false;
+
+-- +goose Down
+ALTER TABLE schedule DROP COLUMN reanchor;
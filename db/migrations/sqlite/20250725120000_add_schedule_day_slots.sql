@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE schedule ADD COLUMN day_slots text null;
+
+-- +goose Down
+ALTER TABLE schedule DROP COLUMN day_slots;
@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE schedule ADD COLUMN final_dose_at datetime null;
+
+-- +goose Down
+ALTER TABLE schedule DROP COLUMN final_dose_at;
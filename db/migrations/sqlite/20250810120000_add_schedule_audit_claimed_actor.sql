@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE schedule_audit ADD COLUMN claimed_actor varchar(255) not null default '';
+
+-- +goose Down
+ALTER TABLE schedule_audit DROP COLUMN claimed_actor;
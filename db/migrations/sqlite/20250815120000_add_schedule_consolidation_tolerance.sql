@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE schedule ADD COLUMN consolidation_tolerance bigint null;
+
+-- +goose Down
+ALTER TABLE schedule DROP COLUMN consolidation_tolerance;
@@ -2,14 +2,20 @@ package app
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
+	"fmt"
 	"github.com/gorilla/mux"
+	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors"
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/logging"
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/recovery"
+	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/selector"
+	"github.com/prometheus/client_golang/prometheus"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/status"
-	"log"
 	"log/slog"
 	"net"
 	"net/http"
@@ -18,88 +24,360 @@ import (
 	"schedule/internal/app/logger"
 	"schedule/internal/config"
 	"schedule/internal/domain/usecase/schedule"
-	"schedule/internal/infrastructure/persistence/mysql"
+	"schedule/internal/infrastructure/metrics"
+	"schedule/internal/infrastructure/persistence/migrate"
 	"schedule/internal/server/grpcserver"
+	"schedule/internal/server/health"
 	"schedule/internal/server/httpserver"
 	"schedule/pkg/contextx"
+	schedulev1 "schedule/pkg/grpc"
+	schedulev2 "schedule/pkg/grpc/v2"
 	"schedule/pkg/interceptorx"
 	"schedule/pkg/middlwarex"
+	"schedule/pkg/ratelimit"
+	"schedule/pkg/tlsx"
 	"syscall"
+	"time"
 )
 
-func Run(cfg *config.Config) {
-	l, err := logger.GetLogger(&cfg.Log)
-	if err != nil {
-		log.Fatal(err)
+const (
+	healthCheckInterval   = 5 * time.Second
+	tracerShutdownTimeout = 5 * time.Second // pending spans are dropped if collector is unavailable
+)
+
+// App is schedule service. Components are started in order by Start and stopped in reverse order by Stop.
+type App struct {
+	cfg *config.Config
+	l   *slog.Logger
+
+	clock   schedule.Clock
+	checker *health.Checker
+	stops   []stopFunc // in start order
+	errs    chan error
+
+	httpAddr net.Addr
+	grpcAddr net.Addr
+}
+
+type stopFunc struct {
+	name string
+	stop func(ctx context.Context) error
+}
+
+type Option func(a *App)
+
+// WithClock replaces system clock of usecase, e.g. to freeze time in tests.
+func WithClock(clock schedule.Clock) Option {
+	return func(a *App) {
+		a.clock = clock
 	}
+}
+
+func New(cfg *config.Config, opts ...Option) *App {
+	a := &App{
+		cfg:   cfg,
+		clock: schedule.SystemClock,
+		errs:  make(chan error, 3),
+	}
+	for _, opt := range opts {
+		opt(a)
+	}
+	return a
+}
 
+// Run starts app and stops it on SIGINT, SIGTERM or server error, shutdown is bounded by HttpServer.ShutdownTimeout.
+func Run(cfg *config.Config) error {
 	shutdown := make(chan os.Signal, 2)
 	signal.Notify(shutdown, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(shutdown)
 
-	db, err := mysql.Connect(cfg.MySQl)
-	if err != nil {
-		log.Fatal(err)
+	a := New(cfg)
+	if err := a.Start(context.Background()); err != nil {
+		return err
 	}
-	defer db.Close()
 
-	scheduleRepo := mysql.NewScheduleRepo(db)
+	var runErr error
+	select {
+	case <-shutdown:
+	case runErr = <-a.Err():
+	}
 
-	scheduleUsecase := schedule.NewUsecase(scheduleRepo, cfg.Schedule)
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.HttpServer.ShutdownTimeout)
+	defer cancel()
 
-	httpServer := newHttpServer(l, scheduleUsecase, cfg.HttpServer)
-	grpcServer := newGrpcServer(l, scheduleUsecase)
+	return errors.Join(runErr, a.Stop(ctx))
+}
 
-	go func() {
-		if err := httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
-			log.Fatal(err)
+// Start connects to database and starts servers, started components are stopped if start fails.
+func (a *App) Start(ctx context.Context) (err error) {
+	defer func() {
+		if err != nil {
+			err = errors.Join(err, a.Stop(context.Background()))
 		}
 	}()
 
-	go func() {
-		listener, err := net.Listen("tcp", cfg.GrpcServer.Addr)
+	cfg := a.cfg
+
+	a.l, err = logger.GetLogger(&cfg.Log)
+	if err != nil {
+		return err
+	}
+
+	tracerProvider, err := setupTracing(ctx, cfg.Tracing)
+	if err != nil {
+		return err
+	}
+	a.onStop("tracer provider", func(ctx context.Context) error {
+		// pending spans are dropped if collector is unavailable
+		ctx, cancel := context.WithTimeout(ctx, tracerShutdownTimeout)
+		defer cancel()
+		return tracerProvider.Shutdown(ctx)
+	})
+
+	db, err := ConnectDB(cfg)
+	if err != nil {
+		return err
+	}
+	if db != nil {
+		a.onStop("database", func(context.Context) error {
+			return db.Close()
+		})
+
+		// single node sqlite is always migrated, so it runs without migration tool
+		if cfg.Database.MigrateOnStartup || cfg.Database.Driver == config.DriverSQLite {
+			results, err := migrate.UpLocked(ctx, db.DB, cfg.Database.Driver, cfg.Database.MigrateLockTimeout)
+			if err != nil {
+				return fmt.Errorf("migrate: %w", err)
+			}
+			a.l.Info("migrations applied", slog.Int("count", len(results)))
+		}
+	}
+
+	registry := metrics.NewRegistry()
+	if db != nil {
+		metrics.RegisterDBStats(registry, db.DB, cfg.Database.Driver)
+	}
+
+	scheduleRepo := newScheduleRepo(cfg.Database.Driver, db, a.clock)
+
+	scheduleUsecase := schedule.NewUsecase(scheduleRepo, cfg.Schedule, a.clock, metrics.NewScheduleMetrics(registry))
+
+	var pinger health.Pinger // memory storage has nothing to ping
+	if db != nil {
+		pinger = db
+	}
+	a.checker = health.NewChecker(pinger, schedulev1.Schedule_ServiceDesc.ServiceName, schedulev2.ScheduleService_ServiceDesc.ServiceName)
+
+	watchCtx, stopWatch := context.WithCancel(contextx.WithLogger(context.Background(), a.l))
+	go a.checker.Watch(watchCtx, healthCheckInterval)
+	a.onStop("health watch", func(context.Context) error {
+		stopWatch()
+		return nil
+	})
+
+	var limiter *ratelimit.Limiter
+	var keyer *ratelimit.Keyer
+	if cfg.RateLimit.Enabled {
+		limiter = ratelimit.NewLimiter(ratelimit.NewMemoryStore(), ratelimit.Limit{
+			Rate:  cfg.RateLimit.Rate,
+			Burst: cfg.RateLimit.Burst,
+		})
+		keyer, err = ratelimit.NewKeyer(ratelimit.KeyMode(cfg.RateLimit.Key), cfg.RateLimit.APIKeys)
 		if err != nil {
-			log.Fatal(err)
+			return err
+		}
+	}
+
+	if metricsServer := newMetricsServer(a.l, registry, cfg.Metrics); metricsServer != nil {
+		if _, err := a.serveHttp("metrics server", metricsServer); err != nil {
+			return err
+		}
+	}
+
+	grpcTLS, err := a.newTLSConfig("grpc", cfg.GrpcServer.TLS)
+	if err != nil {
+		return err
+	}
+	grpcServer := newGrpcServer(a.l, scheduleUsecase, a.checker, registry, limiter, keyer, grpcTLS, cfg.Debug)
+	if a.grpcAddr, err = a.serveGrpc(grpcServer); err != nil {
+		return err
+	}
+
+	httpServer := newHttpServer(a.l, scheduleUsecase, a.checker, registry, limiter, keyer, cfg.HttpServer, cfg.Debug)
+	if httpServer.TLSConfig, err = a.newTLSConfig("http", cfg.HttpServer.TLS); err != nil {
+		return err
+	}
+	if a.httpAddr, err = a.serveHttp("http server", httpServer); err != nil {
+		return err
+	}
+
+	a.l.Info("app started", slog.String("http_addr", a.httpAddr.String()), slog.String("grpc_addr", a.grpcAddr.String()))
+
+	return nil
+}
+
+// Stop marks app as not ready and stops components in reverse order.
+// Servers are stopped forcibly when ctx is done.
+func (a *App) Stop(ctx context.Context) error {
+	// readiness fails first, so balancers stop routing before servers are stopped
+	if a.checker != nil {
+		a.checker.Shutdown()
+	}
+
+	var errs []error
+	for i := len(a.stops) - 1; i >= 0; i-- {
+		s := a.stops[i]
+		if err := s.stop(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("stop %s: %w", s.name, err))
 		}
+	}
+	a.stops = nil
+
+	return errors.Join(errs...)
+}
+
+// Err reports errors of servers after start, app must be stopped after error.
+func (a *App) Err() <-chan error {
+	return a.errs
+}
 
-		if err := grpcServer.Serve(listener); err != nil {
-			log.Fatal(err)
+// HttpAddr is address of http server listener, it is known after start.
+func (a *App) HttpAddr() net.Addr {
+	return a.httpAddr
+}
+
+// GrpcAddr is address of grpc server listener, it is known after start.
+func (a *App) GrpcAddr() net.Addr {
+	return a.grpcAddr
+}
+
+func (a *App) onStop(name string, stop func(ctx context.Context) error) {
+	a.stops = append(a.stops, stopFunc{name: name, stop: stop})
+}
+
+// serveHttp listens before returning, so address errors are returned from Start.
+func (a *App) serveHttp(name string, server *http.Server) (net.Addr, error) {
+	listener, err := net.Listen("tcp", server.Addr)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+
+	go func() {
+		serve := server.Serve
+		if server.TLSConfig != nil {
+			serve = func(l net.Listener) error { return server.ServeTLS(l, "", "") }
+		}
+
+		if err := serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			a.errs <- fmt.Errorf("%s: %w", name, err)
 		}
 	}()
 
-	<-shutdown
+	a.onStop(name, func(ctx context.Context) error {
+		if err := server.Shutdown(ctx); err != nil {
+			server.Close()
+			return err
+		}
+		return nil
+	})
+
+	return listener.Addr(), nil
+}
+
+// newTLSConfig returns nil if tls is disabled, certificates are reloaded until app is stopped.
+func (a *App) newTLSConfig(name string, cfg config.TLSConfig) (*tls.Config, error) {
+	if !cfg.Enabled() {
+		return nil, nil
+	}
 
-	if err := httpServer.Shutdown(context.Background()); err != nil {
-		log.Println("shutdown http server failed:", err)
+	reloader, err := tlsx.NewReloader(cfg.CertFile, cfg.KeyFile, cfg.ClientCAFile)
+	if err != nil {
+		return nil, fmt.Errorf("%s tls: %w", name, err)
 	}
 
-	grpcServer.GracefulStop()
+	watchCtx, stopWatch := context.WithCancel(contextx.WithLogger(context.Background(), a.l))
+	go reloader.Watch(watchCtx, cfg.ReloadInterval)
+	a.onStop(name+" tls reload", func(context.Context) error {
+		stopWatch()
+		return nil
+	})
+
+	return reloader.TLSConfig(), nil
 }
 
-func newHttpServer(l *slog.Logger, schedule *schedule.Usecase, cfg config.HttpServerConfig) *http.Server {
+func (a *App) serveGrpc(server *grpc.Server) (net.Addr, error) {
+	listener, err := net.Listen("tcp", a.cfg.GrpcServer.Addr)
+	if err != nil {
+		return nil, fmt.Errorf("grpc server: %w", err)
+	}
+
+	go func() {
+		if err := server.Serve(listener); err != nil {
+			a.errs <- fmt.Errorf("grpc server: %w", err)
+		}
+	}()
+
+	a.onStop("grpc server", func(ctx context.Context) error {
+		stopped := make(chan struct{})
+		go func() {
+			server.GracefulStop()
+			close(stopped)
+		}()
+
+		select {
+		case <-stopped:
+			return nil
+		case <-ctx.Done():
+			server.Stop()
+			<-stopped
+			return ctx.Err()
+		}
+	})
+
+	return listener.Addr(), nil
+}
+
+func newHttpServer(l *slog.Logger, schedule *schedule.Usecase, checker *health.Checker, reg prometheus.Registerer, limiter *ratelimit.Limiter, keyer *ratelimit.Keyer, cfg config.HttpServerConfig, debug config.DebugConfig) *http.Server {
 	restScheduleServer := httpserver.NewScheduleServer(schedule)
 	restServer := httpserver.NewServer(restScheduleServer)
 
 	rtr := mux.NewRouter()
 	restServer.RegisterRoutes(rtr)
+	httpserver.RegisterHealthRoutes(rtr, checker)
 
 	var sensitiveFields = []string{
 		"user_id", "user-id", "userid",
 	}
+	var probePaths = []string{
+		httpserver.HealthzPath, httpserver.ReadyzPath,
+	}
 
 	rtr.Use(
 		middlwarex.AddTraceId,
+		middlwarex.NewMetrics(reg),
+		middlwarex.AddClientIdentity,
+		middlwarex.AddActor,
 		middlwarex.WithLocation,
 		middlwarex.NewLogRequest(&middlwarex.LogOptions{
 			MaxContentLen:   cfg.Log.MaxRequestContentLen,
 			LoggingContent:  cfg.Log.RequestLoggingContent,
 			SensitiveFields: sensitiveFields,
+			SkipPaths:       probePaths,
 		}),
 		middlwarex.NewLogResponse(&middlwarex.LogOptions{
 			MaxContentLen:   cfg.Log.MaxResponseContentLen,
 			LoggingContent:  cfg.Log.ResponseLoggingContent,
 			SensitiveFields: sensitiveFields,
+			SkipPaths:       probePaths,
 		}),
 	)
+	if limiter != nil {
+		rtr.Use(middlwarex.NewRateLimit(limiter, keyer, probePaths...))
+	}
+	if debug.AllowNowOverride {
+		l.Warn("debug now override is enabled")
+		rtr.Use(middlwarex.OverrideNow)
+	}
 
 	return &http.Server{
 		Handler:      rtr,
@@ -113,7 +391,7 @@ func newHttpServer(l *slog.Logger, schedule *schedule.Usecase, cfg config.HttpSe
 	}
 }
 
-func newGrpcServer(l *slog.Logger, schedule *schedule.Usecase) *grpc.Server {
+func newGrpcServer(l *slog.Logger, schedule *schedule.Usecase, checker *health.Checker, reg prometheus.Registerer, limiter *ratelimit.Limiter, keyer *ratelimit.Keyer, tlsCfg *tls.Config, debug config.DebugConfig) *grpc.Server {
 	loggingOpts := []logging.Option{
 		logging.WithLogOnEvents(
 			logging.PayloadReceived, logging.PayloadSent,
@@ -130,13 +408,53 @@ func newGrpcServer(l *slog.Logger, schedule *schedule.Usecase) *grpc.Server {
 		"user_id", "userid",
 	}
 
-	server := grpc.NewServer(grpc.ChainUnaryInterceptor(
+	interceptors := []grpc.UnaryServerInterceptor{
 		interceptorx.AddLoggerUnaryInterceptor(l),
 		interceptorx.TraceIdUnaryInterceptor,
+		interceptorx.NewMetricsUnaryInterceptor(reg),
+		interceptorx.ClientIdentityUnaryInterceptor,
+		interceptorx.ActorUnaryInterceptor,
 		interceptorx.TimezoneUnaryInterceptor,
 		recovery.UnaryServerInterceptor(recoveryOpts...),
-		logging.UnaryServerInterceptor(interceptorx.NewLoggingInterceptor(safeField), loggingOpts...),
-	))
+		selector.UnaryServerInterceptor(
+			logging.UnaryServerInterceptor(interceptorx.NewLoggingInterceptor(safeField), loggingOpts...),
+			selector.MatchFunc(notHealthCheck),
+		),
+	}
+	if limiter != nil {
+		interceptors = append(interceptors, selector.UnaryServerInterceptor(
+			interceptorx.NewRateLimitUnaryInterceptor(limiter, keyer),
+			selector.MatchFunc(notHealthCheck),
+		))
+	}
+
+	if debug.AllowNowOverride {
+		interceptors = append(interceptors, interceptorx.OverrideNowUnaryInterceptor)
+	}
+
+	opts := []grpc.ServerOption{grpc.ChainUnaryInterceptor(interceptors...)}
+	if tlsCfg != nil {
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsCfg)))
+	}
+
+	server := grpc.NewServer(opts...)
 	grpcserver.Register(server, schedule)
+	healthpb.RegisterHealthServer(server, checker.GRPCServer())
 	return server
 }
+
+func newMetricsServer(l *slog.Logger, reg *prometheus.Registry, cfg config.MetricsConfig) *http.Server {
+	if cfg.Addr == "" {
+		return nil
+	}
+
+	return &http.Server{
+		Handler:  metrics.Handler(reg),
+		Addr:     cfg.Addr,
+		ErrorLog: slog.NewLogLogger(l.Handler(), slog.LevelError),
+	}
+}
+
+func notHealthCheck(_ context.Context, c interceptors.CallMeta) bool {
+	return c.Service != healthpb.Health_ServiceDesc.ServiceName
+}
@@ -0,0 +1,54 @@
+package app
+
+import (
+	"context"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"schedule/internal/app/logger"
+	"schedule/internal/config"
+	"testing"
+	"time"
+)
+
+func TestAppStartStop(t *testing.T) {
+	cfg := &config.Config{
+		Log:      config.LogConfig{Level: "error", Format: logger.LogFormatJson},
+		Database: config.DatabaseConfig{Driver: config.DriverMemory},
+		HttpServer: config.HttpServerConfig{
+			Addr:         "127.0.0.1:0",
+			ReadTimeout:  time.Second,
+			WriteTimeout: time.Second,
+		},
+		GrpcServer: config.GrpcServerConfig{Addr: "127.0.0.1:0"},
+		Tracing:    config.TracingConfig{Exporter: config.TracingExporterNone},
+	}
+
+	a := New(cfg)
+	require.NoError(t, a.Start(context.Background()))
+
+	resp, err := http.Get("http://" + a.HttpAddr().String() + "/readyz")
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	require.NoError(t, a.Stop(ctx))
+
+	_, err = http.Get("http://" + a.HttpAddr().String() + "/readyz")
+	require.Error(t, err)
+}
+
+func TestAppStartError(t *testing.T) {
+	cfg := &config.Config{
+		Log:        config.LogConfig{Level: "error", Format: logger.LogFormatJson},
+		Database:   config.DatabaseConfig{Driver: config.DriverMemory},
+		HttpServer: config.HttpServerConfig{Addr: "127.0.0.1:0"},
+		GrpcServer: config.GrpcServerConfig{Addr: "invalid address"},
+		Tracing:    config.TracingConfig{Exporter: config.TracingExporterNone},
+	}
+
+	a := New(cfg)
+	require.Error(t, a.Start(context.Background()))
+	require.Nil(t, a.HttpAddr(), "http server is not started after grpc failure")
+}
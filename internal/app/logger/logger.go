@@ -2,6 +2,7 @@ package logger
 
 import (
 	"context"
+	"go.opentelemetry.io/otel/trace"
 	"io"
 	"log"
 	"log/slog"
@@ -21,9 +22,12 @@ type logHandler struct {
 }
 
 func (h *logHandler) Handle(ctx context.Context, r slog.Record) error {
-	traceId := contextx.GetTraceId(ctx)
-
-	if traceId != "" {
+	if spanCtx := trace.SpanContextFromContext(ctx); spanCtx.IsValid() {
+		r.AddAttrs(
+			slog.String("trace_id", spanCtx.TraceID().String()),
+			slog.String("span_id", spanCtx.SpanID().String()),
+		)
+	} else if traceId := contextx.GetTraceId(ctx); traceId != "" {
 		r.AddAttrs(slog.String("trace_id", string(traceId)))
 	}
 
@@ -0,0 +1,56 @@
+package app
+
+import (
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"schedule/internal/config"
+	"schedule/internal/domain/usecase/schedule"
+	"schedule/internal/infrastructure/persistence/memory"
+	"schedule/internal/infrastructure/persistence/mysql"
+	"schedule/internal/infrastructure/persistence/postgres"
+	"schedule/internal/infrastructure/persistence/sqlite"
+)
+
+// ConnectDB connects to database selected by config, db is nil for memory driver.
+func ConnectDB(cfg *config.Config) (*sqlx.DB, error) {
+	switch cfg.Database.Driver {
+	case config.DriverMySQL:
+		db, err := mysql.Connect(cfg.MySQl)
+		if err != nil {
+			return nil, fmt.Errorf("connect mysql: %w", err)
+		}
+		return db, nil
+
+	case config.DriverPostgres:
+		db, err := postgres.Connect(cfg.Postgres)
+		if err != nil {
+			return nil, fmt.Errorf("connect postgres: %w", err)
+		}
+		return db, nil
+
+	case config.DriverSQLite:
+		db, err := sqlite.Connect(cfg.SQLite)
+		if err != nil {
+			return nil, fmt.Errorf("connect sqlite: %w", err)
+		}
+		return db, nil
+
+	case config.DriverMemory:
+		return nil, nil
+	}
+
+	return nil, fmt.Errorf("unknown database driver '%s'", cfg.Database.Driver)
+}
+
+func newScheduleRepo(driver string, db *sqlx.DB, clock schedule.Clock) schedule.Repo {
+	switch driver {
+	case config.DriverPostgres:
+		return postgres.NewScheduleRepo(db, clock.Now)
+	case config.DriverSQLite:
+		return sqlite.NewScheduleRepo(db, clock.Now)
+	case config.DriverMemory:
+		return memory.NewScheduleRepo(clock.Now)
+	default:
+		return mysql.NewScheduleRepo(db, clock.Now)
+	}
+}
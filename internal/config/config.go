@@ -2,25 +2,36 @@ package config
 
 import (
 	"errors"
+	"fmt"
 	"github.com/ilyakaznacheev/cleanenv"
 	"github.com/joho/godotenv"
 	"os"
+	"schedule/pkg/ratelimit"
 	"time"
 )
 
 type Config struct {
 	Schedule   ScheduleConfig   `yaml:"schedule"`
 	Log        LogConfig        `yaml:"log"`
+	Database   DatabaseConfig   `yaml:"database"`
 	MySQl      MySqlConfig      `yaml:"mysql"`
+	Postgres   PostgresConfig   `yaml:"postgres"`
+	SQLite     SQLiteConfig     `yaml:"sqlite"`
 	HttpServer HttpServerConfig `yaml:"http_server"`
 	GrpcServer GrpcServerConfig `yaml:"grpc_server"`
+	Metrics    MetricsConfig    `yaml:"metrics"`
+	Tracing    TracingConfig    `yaml:"tracing"`
+	RateLimit  RateLimitConfig  `yaml:"rate_limit"`
+	Debug      DebugConfig      `yaml:"debug"`
 }
 
 type ScheduleConfig struct {
-	NextTakingPeriod time.Duration `yaml:"next_taking_period" env:"NEXT_TAKING_PERIOD" env-default:"1h"`
-	BeginDayHour     int           `yaml:"begin_day_hour" env:"BEGIN_DAY_HOUR" env-default:"8"`
-	EndDayHour       int           `yaml:"end_day_hour" env:"END_DAY_HOUR" env-default:"22"`
-	TimeRound        time.Duration `yaml:"time_round" env:"TIME_ROUND" env-default:"15m"`
+	NextTakingPeriod       time.Duration `yaml:"next_taking_period" env:"NEXT_TAKING_PERIOD" env-default:"1h"`
+	BeginDayHour           int           `yaml:"begin_day_hour" env:"BEGIN_DAY_HOUR" env-default:"8"`
+	EndDayHour             int           `yaml:"end_day_hour" env:"END_DAY_HOUR" env-default:"22"`
+	TimeRound              time.Duration `yaml:"time_round" env:"TIME_ROUND" env-default:"15m"`
+	ConsolidationTolerance time.Duration `yaml:"consolidation_tolerance" env:"CONSOLIDATION_TOLERANCE" env-default:"30m"` // max shift of dose if schedule has no own tolerance, limited by quarter of period
+	LateDoseTolerance      time.Duration `yaml:"late_dose_tolerance" env:"LATE_DOSE_TOLERANCE" env-default:"15m"`         // intake later than taking by more is late
 }
 
 type LogConfig struct {
@@ -29,6 +40,19 @@ type LogConfig struct {
 	Format string `yaml:"format" env:"LOG_FORMAT" env-default:"json"`
 }
 
+const (
+	DriverMySQL    = "mysql"
+	DriverPostgres = "postgres"
+	DriverSQLite   = "sqlite"
+	DriverMemory   = "memory"
+)
+
+type DatabaseConfig struct {
+	Driver             string        `yaml:"driver" env:"DB_DRIVER" env-default:"mysql"` // mysql, postgres, sqlite or memory
+	MigrateOnStartup   bool          `yaml:"migrate_on_startup" env:"DB_MIGRATE_ON_STARTUP" env-default:"false"`
+	MigrateLockTimeout time.Duration `yaml:"migrate_lock_timeout" env:"DB_MIGRATE_LOCK_TIMEOUT" env-default:"1m"`
+}
+
 type MySqlConfig struct {
 	Addr           string `yaml:"addr" env:"MYSQL_ADDR" env-default:"localhost:3306"`
 	User           string `yaml:"user" env:"MYSQL_USER" env-default:"root"`
@@ -37,12 +61,27 @@ type MySqlConfig struct {
 	ConnectTimeout int    `yaml:"connect_timeout" env:"DB_CONNECT_TIMEOUT" env-default:"10"`
 }
 
+type PostgresConfig struct {
+	Addr           string `yaml:"addr" env:"POSTGRES_ADDR" env-default:"localhost:5432"`
+	User           string `yaml:"user" env:"POSTGRES_USER" env-default:"postgres"`
+	Password       string `yaml:"password" env:"POSTGRES_PASSWORD" env-default:""`
+	Database       string `yaml:"database" env:"POSTGRES_DATABASE" env-default:"schedule"`
+	SSLMode        string `yaml:"ssl_mode" env:"POSTGRES_SSL_MODE" env-default:"disable"`
+	ConnectTimeout int    `yaml:"connect_timeout" env:"DB_CONNECT_TIMEOUT" env-default:"10"`
+}
+
+type SQLiteConfig struct {
+	Path           string `yaml:"path" env:"SQLITE_PATH" env-default:"schedule.db"`
+	ConnectTimeout int    `yaml:"connect_timeout" env:"DB_CONNECT_TIMEOUT" env-default:"10"`
+}
+
 type HttpServerConfig struct {
 	Addr            string        `yaml:"addr" env:"HTTP_ADDR" env-default:"localhost:8080"`
 	ReadTimeout     time.Duration `yaml:"read_timeout" env:"HTTP_READ_TIMEOUT" env-default:"10s"`
 	WriteTimeout    time.Duration `yaml:"write_timeout" env:"HTTP_WRITE_TIMEOUT" env-default:"10s"`
 	ShutdownTimeout time.Duration `yaml:"shutdown_timeout" env:"HTTP_SHUTDOWN_TIMEOUT" env-default:"10s"`
 	Log             HttpLog       `yaml:"log"`
+	TLS             TLSConfig     `yaml:"tls" env-prefix:"HTTP_"`
 }
 
 type HttpLog struct {
@@ -53,7 +92,71 @@ type HttpLog struct {
 }
 
 type GrpcServerConfig struct {
-	Addr string `yaml:"addr" env:"GRPC_ADDR" env-default:"localhost:8081"`
+	Addr string    `yaml:"addr" env:"GRPC_ADDR" env-default:"localhost:8081"`
+	TLS  TLSConfig `yaml:"tls" env-prefix:"GRPC_"`
+}
+
+// TLSConfig enables tls if cert and key files are set, and mutual tls if client ca file is set.
+type TLSConfig struct {
+	CertFile       string        `yaml:"cert_file" env:"TLS_CERT_FILE" env-default:""`
+	KeyFile        string        `yaml:"key_file" env:"TLS_KEY_FILE" env-default:""`
+	ClientCAFile   string        `yaml:"client_ca_file" env:"TLS_CLIENT_CA_FILE" env-default:""`
+	ReloadInterval time.Duration `yaml:"reload_interval" env:"TLS_RELOAD_INTERVAL" env-default:"1m"` // files are checked for changes
+}
+
+func (c TLSConfig) Enabled() bool {
+	return c.CertFile != "" && c.KeyFile != ""
+}
+
+type MetricsConfig struct {
+	Addr string `yaml:"addr" env:"METRICS_ADDR" env-default:"localhost:9090"` // empty disables metrics server
+}
+
+const (
+	TracingExporterNone   = "none"
+	TracingExporterOTLP   = "otlp"
+	TracingExporterStdout = "stdout"
+)
+
+type TracingConfig struct {
+	Exporter     string  `yaml:"exporter" env:"TRACING_EXPORTER" env-default:"none"` // none, otlp or stdout
+	OTLPEndpoint string  `yaml:"otlp_endpoint" env:"TRACING_OTLP_ENDPOINT" env-default:"localhost:4317"`
+	OTLPInsecure bool    `yaml:"otlp_insecure" env:"TRACING_OTLP_INSECURE" env-default:"true"`
+	ServiceName  string  `yaml:"service_name" env:"TRACING_SERVICE_NAME" env-default:"schedule"`
+	SampleRatio  float64 `yaml:"sample_ratio" env:"TRACING_SAMPLE_RATIO" env-default:"1"` // for traces without sampled parent
+}
+
+type RateLimitConfig struct {
+	Enabled bool     `yaml:"enabled" env:"RATE_LIMIT_ENABLED" env-default:"false"`
+	Rate    float64  `yaml:"rate" env:"RATE_LIMIT_RATE" env-default:"10"` // requests per second for each key
+	Burst   int      `yaml:"burst" env:"RATE_LIMIT_BURST" env-default:"20"`
+	Key     string   `yaml:"key" env:"RATE_LIMIT_KEY" env-default:"client"`     // client, api_key, user or ip, unauthenticated requests are limited by ip
+	APIKeys []string `yaml:"api_keys" env:"RATE_LIMIT_API_KEYS" env-default:""` // accepted X-Api-Key values
+}
+
+// Validate checks limits of enabled rate limiter, limiter divides by rate.
+func (c RateLimitConfig) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.Rate <= 0 {
+		return errors.New("rate_limit.rate must be positive")
+	}
+	if c.Burst <= 0 {
+		return errors.New("rate_limit.burst must be positive")
+	}
+	if _, err := ratelimit.NewKeyer(ratelimit.KeyMode(c.Key), c.APIKeys); err != nil {
+		return fmt.Errorf("rate_limit.key: %w", err)
+	}
+	if c.Key == string(ratelimit.KeyAPIKey) && len(c.APIKeys) == 0 {
+		return errors.New("rate_limit.api_keys must be set for api_key key")
+	}
+	return nil
+}
+
+// DebugConfig enables features for testing, they must be disabled in production.
+type DebugConfig struct {
+	AllowNowOverride bool `yaml:"allow_now_override" env:"DEBUG_ALLOW_NOW_OVERRIDE" env-default:"false"` // X-Debug-Now header and metadata
 }
 
 func ReadConfig(path string, dotenv ...string) (*Config, error) {
@@ -67,5 +170,8 @@ func ReadConfig(path string, dotenv ...string) (*Config, error) {
 	if err := cleanenv.ReadConfig(path, cfg); err != nil {
 		return nil, err
 	}
+	if err := cfg.RateLimit.Validate(); err != nil {
+		return nil, err
+	}
 	return cfg, nil
 }
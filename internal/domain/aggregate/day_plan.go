@@ -0,0 +1,27 @@
+package aggregate
+
+import (
+	"schedule/internal/domain/value"
+	"time"
+)
+
+// DayPlan is merged timetable of all active schedules of user for one day.
+type DayPlan struct {
+	Date         time.Time // begin of day in user location
+	Slots        []DayPlanSlot
+	Consolidated bool // doses are moved to shared slots
+}
+
+// DayPlanSlot groups doses of schedules rounded to same time.
+type DayPlanSlot struct {
+	Time  value.ScheduleTimeTableItem
+	Doses []DayPlanDose
+}
+
+type DayPlanDose struct {
+	ScheduleId     value.ScheduleId
+	Name           value.ScheduleName
+	PrescribedTime value.ScheduleTimeTableItem // differs from slot time if dose is moved by consolidation
+	TakenAt        *time.Time                  // recorded intake matched to dose, nil if dose is not taken
+	Late           bool                        // intake is later than prescribed time by more than late dose tolerance
+}
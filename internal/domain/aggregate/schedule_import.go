@@ -0,0 +1,32 @@
+package aggregate
+
+import "schedule/internal/domain/value"
+
+type ScheduleImportMode string
+
+const (
+	ScheduleImportModeAtomic ScheduleImportMode = "atomic"  // all rows in single transaction
+	ScheduleImportModePerRow ScheduleImportMode = "per_row" // each valid row saved separately
+)
+
+func ParseScheduleImportMode(s string) (ScheduleImportMode, bool) {
+	switch mode := ScheduleImportMode(s); mode {
+	case ScheduleImportModeAtomic, ScheduleImportModePerRow:
+		return mode, true
+	case "":
+		return ScheduleImportModeAtomic, true
+	}
+	return "", false
+}
+
+type ScheduleImportRow struct {
+	Line     int
+	Schedule *ScheduleWithDuration
+	Err      error // parsing error, row is skipped if set
+}
+
+type ScheduleImportResult struct {
+	Line int
+	Id   value.ScheduleId
+	Err  error
+}
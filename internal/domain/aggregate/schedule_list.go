@@ -0,0 +1,81 @@
+package aggregate
+
+import (
+	"schedule/internal/domain/entity"
+	"schedule/internal/domain/value"
+	"time"
+)
+
+const (
+	DefaultScheduleListLimit = 50
+	MaxScheduleListLimit     = 500
+)
+
+type ScheduleListSort string
+
+const (
+	ScheduleListSortId    ScheduleListSort = "id"
+	ScheduleListSortName  ScheduleListSort = "name"
+	ScheduleListSortEndAt ScheduleListSort = "end_at"
+)
+
+func ParseScheduleListSort(s string) (ScheduleListSort, bool) {
+	switch sort := ScheduleListSort(s); sort {
+	case ScheduleListSortId, ScheduleListSortName, ScheduleListSortEndAt:
+		return sort, true
+	case "":
+		return ScheduleListSortId, true
+	}
+	return "", false
+}
+
+type ScheduleStatus string
+
+const (
+	ScheduleStatusAny     ScheduleStatus = ""
+	ScheduleStatusActive  ScheduleStatus = "active"
+	ScheduleStatusExpired ScheduleStatus = "expired"
+)
+
+func ParseScheduleStatus(s string) (ScheduleStatus, bool) {
+	switch status := ScheduleStatus(s); status {
+	case ScheduleStatusAny, ScheduleStatusActive, ScheduleStatusExpired:
+		return status, true
+	}
+	return "", false
+}
+
+type ScheduleListFilter struct {
+	UserId       value.UserId
+	NameContains string
+	Status       ScheduleStatus
+	EndAtFrom    *time.Time // inclusive date, schedules without end date are not matched
+	EndAtTo      *time.Time // inclusive date, schedules without end date are not matched
+	Sort         ScheduleListSort
+	Desc         bool
+	Cursor       string
+	Limit        int
+}
+
+// ScheduleListQuery is ScheduleListFilter prepared for repository.
+type ScheduleListQuery struct {
+	ScheduleListFilter
+	ActiveSince time.Time           // first end date which is not expired
+	After       *ScheduleListCursor // keyset of last returned schedule
+}
+
+// ScheduleListCursor contains sort keys of last schedule in page.
+// Cursor is valid only for query with same sort and filter.
+type ScheduleListCursor struct {
+	Id     value.ScheduleId   `json:"id"`
+	Name   value.ScheduleName `json:"name,omitempty"`
+	EndAt  *time.Time         `json:"end_at,omitempty"`
+	Sort   ScheduleListSort   `json:"sort"`
+	Desc   bool               `json:"desc,omitempty"`
+	Filter string             `json:"filter"` // hash of filter
+}
+
+type ScheduleList struct {
+	Schedules  []*entity.Schedule
+	NextCursor string
+}
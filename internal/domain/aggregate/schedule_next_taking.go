@@ -8,4 +8,5 @@ type ScheduleNextTaking struct {
 	EndAt      value.ScheduleEndAt
 	Period     value.SchedulePeriod
 	NextTaking value.ScheduleNextTaking
+	Night      bool // taking of round-the-clock schedule outside of day window
 }
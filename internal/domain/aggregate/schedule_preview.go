@@ -0,0 +1,30 @@
+package aggregate
+
+import (
+	"schedule/internal/domain/value"
+	"time"
+)
+
+const (
+	DefaultSchedulePreviewDays = 1
+	MaxSchedulePreviewDays     = 31
+)
+
+// SchedulePreview is timetable of schedule which is not saved yet.
+type SchedulePreview struct {
+	Name           value.ScheduleName
+	EndAt          value.ScheduleEndAt
+	Period         value.SchedulePeriod
+	RoundTheClock  bool
+	TimesPerDay    int // number of fixed takings of day, zero if takings are stepped by period
+	Warnings       []ScheduleWarning
+	FinalDoseAt    *time.Time // last taking of course limited by number of doses
+	RemainingDoses *int       // takings from now to final dose, nil if course is not limited by doses
+	Days           []SchedulePreviewDay
+	NextTakings    []value.ScheduleNextTaking
+}
+
+type SchedulePreviewDay struct {
+	Date      time.Time // begin of day in user location
+	Timetable value.ScheduleTimeTable
+}
@@ -0,0 +1,26 @@
+package aggregate
+
+import (
+	"schedule/internal/domain/value"
+	"time"
+)
+
+// ScheduleSlotReason is why candidate slot was rejected, empty if slot was kept.
+type ScheduleSlotReason string
+
+const (
+	ScheduleSlotReasonNight         ScheduleSlotReason = "night"          // outside of day hours
+	ScheduleSlotReasonExpired       ScheduleSlotReason = "expired"        // after end of schedule
+	ScheduleSlotReasonOutsidePeriod ScheduleSlotReason = "outside_period" // after next taking look-ahead period
+	ScheduleSlotReasonRounded       ScheduleSlotReason = "rounded"        // rounded into previous slot
+	ScheduleSlotReasonPassed        ScheduleSlotReason = "passed"         // not after now
+	ScheduleSlotReasonBeforeAnchor  ScheduleSlotReason = "before_anchor"  // before anchor of schedule
+)
+
+// ScheduleSlotDecision explains whether candidate slot of schedule is in result.
+type ScheduleSlotDecision struct {
+	ScheduleId value.ScheduleId
+	Time       time.Time
+	Kept       bool
+	Reason     ScheduleSlotReason
+}
@@ -0,0 +1,70 @@
+package aggregate
+
+import (
+	"schedule/internal/domain/entity"
+	"schedule/internal/domain/value"
+	"schedule/pkg/failure"
+	"time"
+)
+
+// ScheduleUpdate is partial update of schedule, nil fields are not changed.
+// Duration is counted from now, zero duration removes end date. Period and times per day replace each other.
+// Doses are counted from now and replace duration, zero doses remove limit.
+// Zero consolidation tolerance returns to default of config.
+type ScheduleUpdate struct {
+	Name                   *value.ScheduleName
+	Duration               *value.ScheduleDuration
+	Period                 *value.SchedulePeriod
+	RoundTheClock          *bool
+	AnchorAt               *time.Time
+	Reanchor               *bool
+	TimesPerDay            *int
+	Doses                  *int
+	ConsolidationTolerance *time.Duration
+}
+
+// Validate returns failure.InvalidRequestError with all violations, now bounds anchor.
+func (u ScheduleUpdate) Validate(now time.Time) error {
+	var violations []failure.Violation
+
+	if u.Name != nil {
+		switch {
+		case *u.Name == "":
+			violations = append(violations, failure.Violation{Field: "name", Description: "name must not be empty"})
+		case len(*u.Name) > entity.MaxMedicineNameLen:
+			violations = append(violations, failure.Violation{Field: "name", Description: "medicine name is too long"})
+		}
+	}
+
+	if u.Duration != nil && *u.Duration < 0 {
+		violations = append(violations, failure.Violation{Field: "duration", Description: "duration must not be negative"})
+	}
+
+	if u.Doses != nil {
+		violations = append(violations, validateDoses(*u.Doses, u.Duration != nil)...)
+	}
+
+	if u.TimesPerDay != nil {
+		violations = append(violations, validateTimesPerDay(*u.TimesPerDay, u.Period != nil, u.RoundTheClock != nil && *u.RoundTheClock)...)
+	}
+
+	violations = append(violations, validateAnchorAt(u.AnchorAt, now)...)
+
+	if u.ConsolidationTolerance != nil {
+		violations = append(violations, validateConsolidationTolerance(*u.ConsolidationTolerance)...)
+	}
+
+	if u.Period != nil {
+		switch {
+		case *u.Period < entity.MinSchedulePeriod:
+			violations = append(violations, failure.Violation{Field: "period", Description: "period is too short"})
+		case *u.Period > entity.MaxSchedulePeriod:
+			violations = append(violations, failure.Violation{Field: "period", Description: "period is too long"})
+		}
+	}
+
+	if len(violations) > 0 {
+		return failure.NewValidationError(violations...)
+	}
+	return nil
+}
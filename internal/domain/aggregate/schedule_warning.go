@@ -0,0 +1,8 @@
+package aggregate
+
+// ScheduleWarning is problem of schedule which does not prevent saving it.
+type ScheduleWarning string
+
+const (
+	ScheduleWarningShortInterval ScheduleWarning = "short_interval" // interval between takings is shorter than entity.MinSchedulePeriod
+)
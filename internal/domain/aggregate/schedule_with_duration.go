@@ -1,31 +1,140 @@
 package aggregate
 
 import (
-	"errors"
 	"schedule/internal/domain/entity"
 	"schedule/internal/domain/value"
+	"schedule/pkg/failure"
+	"time"
 )
 
 type ScheduleWithDuration struct {
-	Id       value.ScheduleId
-	UserId   value.UserId
-	Name     value.ScheduleName
-	Duration value.ScheduleDuration
-	Period   value.SchedulePeriod
+	Id                     value.ScheduleId
+	UserId                 value.UserId
+	Name                   value.ScheduleName
+	Duration               value.ScheduleDuration
+	Period                 value.SchedulePeriod
+	RoundTheClock          bool                   // takings continue through night from begin of first day
+	AnchorAt               *time.Time             // time of first taking, takings are stepped from it
+	Reanchor               bool                   // late intake moves anchor
+	TimesPerDay            int                    // takings are spread evenly over day instead of period
+	DaySlots               value.ScheduleDaySlots // fixed times of takings instead of period, e.g. from FHIR timeOfDay
+	Doses                  int                    // course ends with this taking instead of duration, zero means no limit
+	ConsolidationTolerance time.Duration          // max shift of dose by consolidation, zero means default
 }
 
-func (t ScheduleWithDuration) Validate() error {
+// Validate returns failure.InvalidRequestError with all violations, now bounds anchor.
+func (t ScheduleWithDuration) Validate(now time.Time) error {
+	var violations []failure.Violation
+
+	if t.UserId == 0 {
+		violations = append(violations, failure.Violation{Field: "user_id", Description: "user id is required"})
+	}
+
 	switch {
-	case t.UserId == 0:
-		return errors.New("user id is required")
 	case t.Name == "":
-		return errors.New("name is required")
+		violations = append(violations, failure.Violation{Field: "name", Description: "name is required"})
 	case len(t.Name) > entity.MaxMedicineNameLen:
-		return errors.New("medicine name is too long")
+		violations = append(violations, failure.Violation{Field: "name", Description: "medicine name is too long"})
+	}
+
+	switch {
+	case len(t.DaySlots) != 0:
+		violations = append(violations, validateDaySlots(t.DaySlots, t.Period != 0 || t.TimesPerDay != 0, t.RoundTheClock)...)
+	case t.TimesPerDay != 0:
+		violations = append(violations, validateTimesPerDay(t.TimesPerDay, t.Period != 0, t.RoundTheClock)...)
 	case t.Period < entity.MinSchedulePeriod:
-		return errors.New("period is too short")
+		violations = append(violations, failure.Violation{Field: "period", Description: "period is too short"})
 	case t.Period > entity.MaxSchedulePeriod:
-		return errors.New("period is too long")
+		violations = append(violations, failure.Violation{Field: "period", Description: "period is too long"})
+	}
+
+	if t.Doses != 0 {
+		violations = append(violations, validateDoses(t.Doses, t.Duration != 0)...)
+	}
+
+	violations = append(violations, validateAnchorAt(t.AnchorAt, now)...)
+	violations = append(violations, validateConsolidationTolerance(t.ConsolidationTolerance)...)
+
+	if len(violations) > 0 {
+		return failure.NewValidationError(violations...)
+	}
+	return nil
+}
+
+func validateTimesPerDay(timesPerDay int, withPeriod, roundTheClock bool) []failure.Violation {
+	var violations []failure.Violation
+
+	switch {
+	case timesPerDay < 1:
+		violations = append(violations, failure.Violation{Field: "times_per_day", Description: "times per day must be positive"})
+	case timesPerDay > entity.MaxTimesPerDay:
+		violations = append(violations, failure.Violation{Field: "times_per_day", Description: "times per day is too big"})
+	}
+	if withPeriod {
+		violations = append(violations, failure.Violation{Field: "period", Description: "period must not be set with times per day"})
+	}
+	if roundTheClock {
+		violations = append(violations, failure.Violation{Field: "round_the_clock", Description: "round-the-clock schedule must have period"})
+	}
+
+	return violations
+}
+
+func validateDaySlots(slots value.ScheduleDaySlots, withPeriod, roundTheClock bool) []failure.Violation {
+	var violations []failure.Violation
+
+	if len(slots) > entity.MaxTimesPerDay {
+		violations = append(violations, failure.Violation{Field: "day_slots", Description: "too many day slots"})
+	}
+	for _, slot := range slots {
+		if slot < 0 || slot >= 24*time.Hour {
+			violations = append(violations, failure.Violation{Field: "day_slots", Description: "day slot must be time of day"})
+			break
+		}
+	}
+	if withPeriod {
+		violations = append(violations, failure.Violation{Field: "period", Description: "period must not be set with day slots"})
+	}
+	if roundTheClock {
+		violations = append(violations, failure.Violation{Field: "round_the_clock", Description: "round-the-clock schedule must have period"})
+	}
+
+	return violations
+}
+
+// validateAnchorAt bounds anchor by entity.MaxAnchorShift around now, far anchor makes stepping of takings too long.
+func validateAnchorAt(anchorAt *time.Time, now time.Time) []failure.Violation {
+	if anchorAt == nil {
+		return nil
+	}
+	if shift := anchorAt.Sub(now); shift > entity.MaxAnchorShift || shift < -entity.MaxAnchorShift {
+		return []failure.Violation{{Field: "anchor_at", Description: "anchor at is too far from now"}}
+	}
+	return nil
+}
+
+func validateConsolidationTolerance(tolerance time.Duration) []failure.Violation {
+	switch {
+	case tolerance < 0:
+		return []failure.Violation{{Field: "consolidation_tolerance", Description: "consolidation tolerance must not be negative"}}
+	case tolerance > entity.MaxConsolidationTolerance:
+		return []failure.Violation{{Field: "consolidation_tolerance", Description: "consolidation tolerance is too big"}}
 	}
 	return nil
 }
+
+func validateDoses(doses int, withDuration bool) []failure.Violation {
+	var violations []failure.Violation
+
+	switch {
+	case doses < 0:
+		violations = append(violations, failure.Violation{Field: "doses", Description: "doses must not be negative"})
+	case doses > entity.MaxScheduleDoses:
+		violations = append(violations, failure.Violation{Field: "doses", Description: "doses is too big"})
+	}
+	if withDuration {
+		violations = append(violations, failure.Violation{Field: "duration", Description: "duration must not be set with doses"})
+	}
+
+	return violations
+}
@@ -1,11 +1,23 @@
 package aggregate
 
-import "schedule/internal/domain/value"
+import (
+	"schedule/internal/domain/value"
+	"time"
+)
 
 type ScheduleWithTimetable struct {
-	Id        value.ScheduleId
-	Name      value.ScheduleName
-	EndAt     value.ScheduleEndAt
-	Period    value.SchedulePeriod
-	Timetable value.ScheduleTimeTable
+	Id                     value.ScheduleId
+	Name                   value.ScheduleName
+	EndAt                  value.ScheduleEndAt
+	Period                 value.SchedulePeriod
+	RoundTheClock          bool
+	AnchorAt               *time.Time
+	Reanchor               bool
+	TimesPerDay            int                    // number of fixed takings of day, zero if takings are stepped by period
+	DaySlots               value.ScheduleDaySlots // fixed times of takings, nil if takings are stepped by period
+	Warnings               []ScheduleWarning
+	FinalDoseAt            *time.Time     // last taking of course limited by number of doses
+	RemainingDoses         *int           // takings from now to final dose, nil if course is not limited by doses
+	ConsolidationTolerance *time.Duration // nil if default of config is used
+	Timetable              value.ScheduleTimeTable
 }
@@ -0,0 +1,84 @@
+package entity
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"schedule/internal/domain/value"
+	"time"
+)
+
+type AuditAction string
+
+const (
+	AuditActionCreate AuditAction = "create"
+	AuditActionUpdate AuditAction = "update"
+	AuditActionDelete AuditAction = "delete"
+)
+
+// AuditRecord is append-only record of schedule change.
+type AuditRecord struct {
+	Id           int64             `db:"id"`
+	ScheduleId   value.ScheduleId  `db:"schedule_id"`
+	UserId       value.UserId      `db:"user_id" json:"-"`
+	Actor        string            `db:"actor"`         // authenticated client or anonymous
+	ClaimedActor string            `db:"claimed_actor"` // X-Actor of client, not verified
+	Action       AuditAction       `db:"action"`
+	Before       *ScheduleSnapshot `db:"before_state"`
+	After        *ScheduleSnapshot `db:"after_state"`
+	TraceId      string            `db:"trace_id"`
+	CreatedAt    time.Time         `db:"created_at"`
+}
+
+// ScheduleSnapshot is state of schedule stored in audit as json, without user id.
+type ScheduleSnapshot struct {
+	Name                   value.ScheduleName     `json:"name"`
+	EndAt                  value.ScheduleEndAt    `json:"end_at"`
+	Period                 value.SchedulePeriod   `json:"period"`
+	RoundTheClock          bool                   `json:"round_the_clock,omitempty"`
+	AnchorAt               *time.Time             `json:"anchor_at,omitempty"`
+	Reanchor               bool                   `json:"reanchor,omitempty"`
+	DaySlots               value.ScheduleDaySlots `json:"day_slots,omitempty"`
+	FinalDoseAt            *time.Time             `json:"final_dose_at,omitempty"`
+	ConsolidationTolerance *time.Duration         `json:"consolidation_tolerance,omitempty"`
+}
+
+// NewScheduleSnapshot makes snapshot, end date is truncated to date as it is stored.
+func NewScheduleSnapshot(schedule *Schedule) *ScheduleSnapshot {
+	if schedule == nil {
+		return nil
+	}
+
+	endAt := schedule.EndAt
+	if !endAt.IsNil() {
+		t := endAt.UTC()
+		t = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+		endAt = value.NewScheduleEndAt(&t)
+	}
+
+	return &ScheduleSnapshot{
+		Name:                   schedule.Name,
+		EndAt:                  endAt,
+		Period:                 schedule.Period,
+		RoundTheClock:          schedule.RoundTheClock,
+		AnchorAt:               schedule.AnchorAt,
+		Reanchor:               schedule.Reanchor,
+		DaySlots:               schedule.DaySlots,
+		FinalDoseAt:            schedule.FinalDoseAt,
+		ConsolidationTolerance: schedule.ConsolidationTolerance,
+	}
+}
+
+func (s *ScheduleSnapshot) Scan(v any) error {
+	switch v := v.(type) {
+	case []byte:
+		return json.Unmarshal(v, s)
+	case string:
+		return json.Unmarshal([]byte(v), s)
+	}
+	return fmt.Errorf("'%v' (type %T) is not a json", v, v)
+}
+
+func (s ScheduleSnapshot) Value() (driver.Value, error) {
+	return json.Marshal(s)
+}
@@ -0,0 +1,15 @@
+package entity
+
+import (
+	"schedule/internal/domain/value"
+	"time"
+)
+
+// Intake is recorded taking of schedule, it is kept after schedule is deleted.
+type Intake struct {
+	Id         int64              `db:"id"`
+	ScheduleId value.ScheduleId   `db:"schedule_id"`
+	UserId     value.UserId       `db:"user_id" json:"-"`
+	Name       value.ScheduleName `db:"name"` // name of schedule at time of intake
+	TakenAt    time.Time          `db:"taken_at"`
+}
@@ -6,15 +6,25 @@ import (
 )
 
 const (
-	MaxMedicineNameLen = 255
-	MinSchedulePeriod  = value.SchedulePeriod(time.Hour)
-	MaxSchedulePeriod  = value.SchedulePeriod(time.Hour * 24)
+	MaxMedicineNameLen        = 255
+	MinSchedulePeriod         = value.SchedulePeriod(time.Hour)
+	MaxSchedulePeriod         = value.SchedulePeriod(time.Hour * 24)
+	MaxTimesPerDay            = 24
+	MaxScheduleDoses          = 1000
+	MaxAnchorShift            = 366 * 24 * time.Hour // anchor is at most about a year from now, takings are stepped from it
+	MaxConsolidationTolerance = time.Duration(MaxSchedulePeriod) / 4
 )
 
 type Schedule struct {
-	Id     value.ScheduleId     `db:"id"`
-	UserId value.UserId         `db:"user_id" json:"-"`
-	Name   value.ScheduleName   `db:"name"`
-	EndAt  value.ScheduleEndAt  `db:"end_at"`
-	Period value.SchedulePeriod `db:"period"`
+	Id                     value.ScheduleId       `db:"id"`
+	UserId                 value.UserId           `db:"user_id" json:"-"`
+	Name                   value.ScheduleName     `db:"name"`
+	EndAt                  value.ScheduleEndAt    `db:"end_at"`
+	Period                 value.SchedulePeriod   `db:"period"`
+	RoundTheClock          bool                   `db:"round_the_clock"`         // takings continue through night
+	AnchorAt               *time.Time             `db:"anchor_at"`               // takings are counted from it, in UTC, nil means from begin of each day
+	Reanchor               bool                   `db:"reanchor"`                // late intake moves anchor
+	DaySlots               value.ScheduleDaySlots `db:"day_slots"`               // fixed times of takings, nil means takings are stepped by period
+	FinalDoseAt            *time.Time             `db:"final_dose_at"`           // last taking of course limited by number of doses, in UTC, nil means no limit
+	ConsolidationTolerance *time.Duration         `db:"consolidation_tolerance"` // max shift of dose by consolidation, nil means default of config
 }
@@ -0,0 +1,9 @@
+package entity
+
+import "schedule/internal/domain/value"
+
+// UserSettings are preferences of user, user without saved settings has zero settings.
+type UserSettings struct {
+	UserId               value.UserId `db:"user_id" json:"-"`
+	ConsolidateReminders bool         `db:"consolidate_reminders"`
+}
@@ -0,0 +1,29 @@
+package schedule
+
+import (
+	"context"
+	"schedule/pkg/contextx"
+	"time"
+)
+
+// Clock is source of current time.
+type Clock interface {
+	Now() time.Time
+}
+
+type ClockFunc func() time.Time
+
+func (f ClockFunc) Now() time.Time {
+	return f()
+}
+
+var SystemClock Clock = ClockFunc(time.Now)
+
+// Now returns current time in user location, it may be overridden for request by debug header.
+func (uc *Usecase) Now(ctx context.Context) time.Time {
+	now, ok := contextx.GetNowOverride(ctx)
+	if !ok {
+		now = uc.clock.Now()
+	}
+	return now.In(contextx.GetLocationOrDefault(ctx))
+}
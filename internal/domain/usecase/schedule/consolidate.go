@@ -0,0 +1,191 @@
+package schedule
+
+import (
+	"schedule/internal/domain/aggregate"
+	"schedule/internal/domain/entity"
+	"schedule/internal/domain/value"
+	"slices"
+	"time"
+)
+
+// consolidationDose is dose of day plan which may be moved to shared slot inside its window.
+type consolidationDose struct {
+	dose       aggregate.DayPlanDose
+	prescribed time.Time
+	from, to   time.Time          // window of allowed slot times, inside day window
+	prev, next *consolidationDose // neighbour doses of same schedule
+	slot       time.Time
+	assigned   bool
+}
+
+// at is slot of dose if it is assigned, else prescribed time.
+func (d *consolidationDose) at() time.Time {
+	if d.assigned {
+		return d.slot
+	}
+	return d.prescribed
+}
+
+func (d *consolidationDose) inWindow(t time.Time) bool {
+	return !t.Before(d.from) && !t.After(d.to)
+}
+
+// fits checks that dose at t is at least MinSchedulePeriod apart from neighbour doses of same schedule.
+// Not assigned neighbours are checked at prescribed time, so prescribed time always fits when neighbour is assigned later.
+func (d *consolidationDose) fits(t time.Time) bool {
+	if d.prev != nil && t.Sub(d.prev.at()) < time.Duration(entity.MinSchedulePeriod) {
+		return false
+	}
+	if d.next != nil && d.next.at().Sub(t) < time.Duration(entity.MinSchedulePeriod) {
+		return false
+	}
+	return true
+}
+
+// consolidationTolerance is max shift of dose of schedule, default of config is used if schedule has no own tolerance.
+// Quarter of period keeps windows of same schedule apart.
+func (uc *Usecase) consolidationTolerance(schedule *entity.Schedule) time.Duration {
+	tolerance := uc.cfg.ConsolidationTolerance
+	if schedule.ConsolidationTolerance != nil {
+		tolerance = *schedule.ConsolidationTolerance
+	}
+	return min(tolerance, schedule.Period.Duration()/4)
+}
+
+// newConsolidationDoses makes doses of schedule timetable, windows are cut by begin and end of day.
+// Night doses of round-the-clock schedules are not moved.
+func newConsolidationDoses(dose aggregate.DayPlanDose, timetable value.ScheduleTimeTable, tolerance time.Duration, beginOfDay, endOfDay time.Time) []*consolidationDose {
+	doses := make([]*consolidationDose, len(timetable))
+	for i, item := range timetable {
+		dose.PrescribedTime = item
+		doses[i] = &consolidationDose{
+			dose:       dose,
+			prescribed: item.Time,
+			from:       latest(item.Add(-tolerance), beginOfDay),
+			to:         earliest(item.Add(tolerance), endOfDay),
+		}
+		if item.Night {
+			doses[i].from, doses[i].to = item.Time, item.Time
+		}
+		if i > 0 {
+			doses[i].prev = doses[i-1]
+			doses[i-1].next = doses[i]
+		}
+	}
+	return doses
+}
+
+// consolidate assigns doses to shared slots to reduce number of distinct reminder times.
+// Candidate slots are prescribed times and times rounded by round inside windows of doses.
+// Doses are taken by end of window, each dose joins nearest opened slot in its window,
+// else opens slot which is joined by most other doses with least total shift.
+// Dose stays at prescribed time if nothing fits.
+func consolidate(doses []*consolidationDose, round time.Duration) {
+	byWindowEnd := slices.Clone(doses)
+	slices.SortStableFunc(byWindowEnd, func(a, b *consolidationDose) int {
+		if c := a.to.Compare(b.to); c != 0 {
+			return c
+		}
+		return a.prescribed.Compare(b.prescribed)
+	})
+
+	var candidates []time.Time
+	for _, d := range doses {
+		candidates = append(candidates, d.prescribed)
+		if round <= 0 {
+			continue
+		}
+		for t := d.from.Truncate(round); !t.After(d.to); t = t.Add(round) {
+			if !t.Before(d.from) {
+				candidates = append(candidates, t)
+			}
+		}
+	}
+	slices.SortFunc(candidates, time.Time.Compare)
+	candidates = slices.CompactFunc(candidates, time.Time.Equal)
+
+	var slots []time.Time // opened slots, sorted
+
+	for _, d := range byWindowEnd {
+		if d.assigned {
+			continue
+		}
+
+		if slot, ok := nearestSlot(d, slots); ok {
+			d.slot, d.assigned = slot, true
+			continue
+		}
+
+		best := d.prescribed
+		var (
+			joiners   []*consolidationDose
+			bestShift time.Duration
+			found     bool
+		)
+		for _, c := range candidates {
+			if !d.inWindow(c) || !d.fits(c) {
+				continue
+			}
+
+			var js []*consolidationDose
+			shift := absDuration(c.Sub(d.prescribed))
+			for _, o := range doses {
+				if o != d && !o.assigned && o.inWindow(c) && o.fits(c) {
+					js = append(js, o)
+					shift += absDuration(c.Sub(o.prescribed))
+				}
+			}
+
+			if !found || len(js) > len(joiners) || (len(js) == len(joiners) && shift < bestShift) {
+				best, joiners, bestShift, found = c, js, shift, true
+			}
+		}
+
+		d.slot, d.assigned = best, true
+		for _, o := range joiners {
+			o.slot, o.assigned = best, true
+		}
+
+		if i, found := slices.BinarySearchFunc(slots, best, time.Time.Compare); !found {
+			slots = slices.Insert(slots, i, best)
+		}
+	}
+}
+
+// nearestSlot finds opened slot in window of dose nearest to prescribed time.
+func nearestSlot(d *consolidationDose, slots []time.Time) (time.Time, bool) {
+	var (
+		nearest time.Time
+		found   bool
+	)
+	for _, slot := range slots {
+		if !d.inWindow(slot) || !d.fits(slot) {
+			continue
+		}
+		if !found || absDuration(slot.Sub(d.prescribed)) < absDuration(nearest.Sub(d.prescribed)) {
+			nearest, found = slot, true
+		}
+	}
+	return nearest, found
+}
+
+func absDuration(d time.Duration) time.Duration {
+	if d < 0 {
+		return -d
+	}
+	return d
+}
+
+func latest(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
+
+func earliest(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
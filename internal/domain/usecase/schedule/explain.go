@@ -0,0 +1,42 @@
+package schedule
+
+import (
+	"schedule/internal/domain/aggregate"
+	"schedule/internal/domain/entity"
+	"time"
+)
+
+// explanation collects decisions about candidate slots, nil explanation collects nothing.
+type explanation struct {
+	decisions []aggregate.ScheduleSlotDecision
+}
+
+func (e *explanation) keep(schedule *entity.Schedule, t time.Time) {
+	if e == nil {
+		return
+	}
+	e.decisions = append(e.decisions, aggregate.ScheduleSlotDecision{
+		ScheduleId: schedule.Id,
+		Time:       t,
+		Kept:       true,
+	})
+}
+
+func (e *explanation) reject(schedule *entity.Schedule, t time.Time, reason aggregate.ScheduleSlotReason) {
+	if e == nil {
+		return
+	}
+	e.decisions = append(e.decisions, aggregate.ScheduleSlotDecision{
+		ScheduleId: schedule.Id,
+		Time:       t,
+		Reason:     reason,
+	})
+}
+
+// getDecisions returns empty slice instead of nil for json.
+func (e *explanation) getDecisions() []aggregate.ScheduleSlotDecision {
+	if e == nil || e.decisions == nil {
+		return []aggregate.ScheduleSlotDecision{}
+	}
+	return e.decisions
+}
@@ -0,0 +1,13 @@
+package schedule
+
+type Metrics interface {
+	SchedulesCreated(n int)
+	NextTakingsComputed()
+	TimetableComputed(size int)
+}
+
+type nopMetrics struct{}
+
+func (nopMetrics) SchedulesCreated(int)  {}
+func (nopMetrics) NextTakingsComputed()  {}
+func (nopMetrics) TimetableComputed(int) {}
@@ -1,7 +1,6 @@
 package schedule
 
 import (
-	"bou.ke/monkey"
 	"context"
 	"fmt"
 	"github.com/stretchr/testify/require"
@@ -9,28 +8,27 @@ import (
 	"schedule/internal/domain/aggregate"
 	"schedule/internal/domain/entity"
 	"schedule/internal/domain/value"
+	"schedule/internal/infrastructure/persistence/memory"
 	"schedule/internal/util"
 	"schedule/pkg/contextx"
+	"schedule/pkg/failure"
 	"testing"
 	"time"
+	_ "time/tzdata" // locations with daylight saving
 )
 
 var testConfig = config.ScheduleConfig{
-	NextTakingPeriod: time.Hour,
-	BeginDayHour:     8,
-	EndDayHour:       22,
-	TimeRound:        time.Minute * 15,
+	NextTakingPeriod:       time.Hour,
+	BeginDayHour:           8,
+	EndDayHour:             22,
+	TimeRound:              time.Minute * 15,
+	ConsolidationTolerance: time.Minute * 30,
+	LateDoseTolerance:      time.Minute * 15,
 }
 
 const testUser value.UserId = 1234567890123456
 
-func init() {
-	now := time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC)
-	monkey.Patch(time.Now, func() time.Time { return now })
-
-	time.Local = nil
-
-}
+var testNow = time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC)
 
 func getTestSchedules(loc *time.Location) []*entity.Schedule {
 	return []*entity.Schedule{
@@ -96,7 +94,7 @@ func TestGetActualSchedulesIds(t *testing.T) {
 
 		testSchedules := getTestSchedules(testCase.Location)
 
-		ids := getActualSchedulesIds(ctx, testSchedules)
+		ids := getActualSchedulesIds(ctx, testSchedules, testNow.In(testCase.Location))
 
 		require.Equalf(t, testCase.Expected, ids, "test case: %d", i+1)
 	}
@@ -169,7 +167,7 @@ func TestGetSchedule(t *testing.T) {
 	for i, testSchedule := range testSchedules {
 		ctx := contextx.WithLocation(context.Background(), time.UTC)
 
-		resp := makeTimetable(ctx, testSchedule, testConfig.BeginDayHour, testConfig.EndDayHour, testConfig.TimeRound)
+		resp := makeTimetable(ctx, testSchedule, testNow, testConfig.BeginDayHour, testConfig.EndDayHour, testConfig.TimeRound, nil)
 
 		require.Equalf(t, expected[i], resp, "test case: %d", i+1)
 	}
@@ -363,12 +361,980 @@ func TestGetNextTaking(t *testing.T) {
 
 		ctx := contextx.WithLocation(context.Background(), c.Location)
 
-		resp := findNextTakings(ctx, testSchedules, c.NextTakingPeriod, testConfig.BeginDayHour, testConfig.EndDayHour, testConfig.TimeRound)
+		resp := findNextTakings(ctx, testSchedules, testNow.In(c.Location), c.NextTakingPeriod, testConfig.BeginDayHour, testConfig.EndDayHour, testConfig.TimeRound, nil)
 
 		require.Equalf(t, c.Expected, resp, "test case: %d", i+1)
 	}
 }
 
+func TestRoundTheClock(t *testing.T) {
+	for _, loc := range []*time.Location{time.UTC, time.FixedZone("", 10*60*60)} {
+		ctx := contextx.WithLocation(context.Background(), loc)
+
+		schedule := &entity.Schedule{
+			Id:            1,
+			UserId:        testUser,
+			Name:          "Test round the clock",
+			Period:        value.SchedulePeriod(time.Hour * 5),
+			RoundTheClock: true,
+			AnchorAt:      util.Ptr(date(loc).Add(time.Hour * 8).UTC()), // stored in UTC
+		}
+
+		slot := func(d time.Time, hour int) value.ScheduleTimeTableItem {
+			t := d.Add(time.Hour * time.Duration(hour))
+			return value.ScheduleTimeTableItem{Time: t, Night: hour < testConfig.BeginDayHour || hour >= testConfig.EndDayHour}
+		}
+		today, tomorrow := date(loc), date(loc).AddDate(0, 0, 1)
+
+		timetable := makeTimetable(ctx, schedule, today.Add(time.Hour*12), testConfig.BeginDayHour, testConfig.EndDayHour, testConfig.TimeRound, nil)
+		require.Equal(t, value.ScheduleTimeTable{slot(today, 8), slot(today, 13), slot(today, 18), slot(today, 23)}, timetable, "location %s", loc)
+		require.Equal(t, value.ScheduleTimeTable{slot(today, 23)}, timetable.Night())
+
+		timetable = makeTimetable(ctx, schedule, tomorrow.Add(time.Hour*12), testConfig.BeginDayHour, testConfig.EndDayHour, testConfig.TimeRound, nil)
+		require.Equal(t, value.ScheduleTimeTable{slot(tomorrow, 4), slot(tomorrow, 9), slot(tomorrow, 14), slot(tomorrow, 19)}, timetable, "location %s", loc)
+
+		nextTakings := findNextTakings(ctx, []*entity.Schedule{schedule}, today.Add(time.Hour*20), time.Hour*10, testConfig.BeginDayHour, testConfig.EndDayHour, testConfig.TimeRound, nil)
+		require.Len(t, nextTakings, 2)
+		require.Equal(t, today.Add(time.Hour*23), nextTakings[0].NextTaking.Time)
+		require.True(t, nextTakings[0].Night)
+		require.Equal(t, tomorrow.Add(time.Hour*4), nextTakings[1].NextTaking.Time)
+		require.True(t, nextTakings[1].Night)
+
+		nextTakings = findNextTakings(ctx, []*entity.Schedule{schedule}, tomorrow.Add(time.Hour*5), time.Hour*5, testConfig.BeginDayHour, testConfig.EndDayHour, testConfig.TimeRound, nil)
+		require.Len(t, nextTakings, 1)
+		require.Equal(t, tomorrow.Add(time.Hour*9), nextTakings[0].NextTaking.Time)
+		require.False(t, nextTakings[0].Night)
+	}
+
+	t.Run("anchor at begin of first day", func(t *testing.T) {
+		ctx := contextx.WithLocation(context.Background(), time.UTC)
+
+		repo := memory.NewScheduleRepo(nil)
+		uc := NewUsecase(repo, testConfig, ClockFunc(func() time.Time { return testNow }), nil)
+
+		id, _, err := uc.Create(ctx, &aggregate.ScheduleWithDuration{UserId: testUser, Name: "Test", Period: value.SchedulePeriod(time.Hour * 6), RoundTheClock: true})
+		require.NoError(t, err)
+
+		schedule, err := repo.GetById(ctx, testUser, id)
+		require.NoError(t, err)
+		require.True(t, schedule.RoundTheClock)
+		require.Equal(t, util.Ptr(date().Add(time.Hour*8)), schedule.AnchorAt)
+
+		timetable, err := uc.GetTimetable(ctx, testUser, id)
+		require.NoError(t, err)
+		require.Equal(t, []string{"08:00:00", "14:00:00", "20:00:00"}, timetable.Timetable.ToStringArray())
+	})
+}
+
+func TestAnchor(t *testing.T) {
+	for _, loc := range []*time.Location{time.UTC, time.FixedZone("", 10*60*60)} {
+		ctx := contextx.WithLocation(context.Background(), loc)
+
+		schedule := &entity.Schedule{
+			Id:       1,
+			UserId:   testUser,
+			Name:     "Test anchor",
+			Period:   value.SchedulePeriod(time.Hour * 5),
+			AnchorAt: util.Ptr(date(loc).Add(time.Hour*9 + time.Minute*30).UTC()),
+		}
+
+		slots := func(d time.Time, times ...time.Duration) value.ScheduleTimeTable {
+			timetable := value.ScheduleTimeTable{}
+			for _, t := range times {
+				timetable = append(timetable, value.NewScheduleTimeTableItem(d.Add(t)))
+			}
+			return timetable
+		}
+		today, tomorrow := date(loc), date(loc).AddDate(0, 0, 1)
+		yesterday := date(loc).AddDate(0, 0, -1)
+
+		timetable := makeTimetable(ctx, schedule, today.Add(time.Hour*12), testConfig.BeginDayHour, testConfig.EndDayHour, testConfig.TimeRound, nil)
+		require.Equal(t, slots(today, time.Hour*9+time.Minute*30, time.Hour*14+time.Minute*30, time.Hour*19+time.Minute*30), timetable, "location %s", loc)
+
+		timetable = makeTimetable(ctx, schedule, tomorrow.Add(time.Hour*12), testConfig.BeginDayHour, testConfig.EndDayHour, testConfig.TimeRound, nil)
+		require.Equal(t, slots(tomorrow, time.Hour*9+time.Minute*30, time.Hour*14+time.Minute*30, time.Hour*19+time.Minute*30), timetable, "location %s", loc)
+
+		timetable = makeTimetable(ctx, schedule, yesterday.Add(time.Hour*12), testConfig.BeginDayHour, testConfig.EndDayHour, testConfig.TimeRound, nil)
+		require.Empty(t, timetable, "location %s", loc)
+
+		nextTakings := findNextTakings(ctx, []*entity.Schedule{schedule}, today.Add(time.Hour*7), time.Hour*5, testConfig.BeginDayHour, testConfig.EndDayHour, testConfig.TimeRound, nil)
+		require.Len(t, nextTakings, 1)
+		require.Equal(t, today.Add(time.Hour*9+time.Minute*30), nextTakings[0].NextTaking.Time)
+	}
+
+	t.Run("late anchor", func(t *testing.T) {
+		ctx := contextx.WithLocation(context.Background(), time.UTC)
+
+		schedule := &entity.Schedule{Id: 1, Period: value.SchedulePeriod(time.Hour * 5), AnchorAt: util.Ptr(date().Add(time.Hour * 21))}
+
+		explain := new(explanation)
+		timetable := makeTimetable(ctx, schedule, testNow, testConfig.BeginDayHour, testConfig.EndDayHour, testConfig.TimeRound, explain)
+		require.Equal(t, []string{"21:00:00"}, timetable.ToStringArray())
+		require.Equal(t, []aggregate.ScheduleSlotDecision{
+			{ScheduleId: 1, Time: date().Add(time.Hour * 11), Reason: aggregate.ScheduleSlotReasonBeforeAnchor},
+			{ScheduleId: 1, Time: date().Add(time.Hour * 16), Reason: aggregate.ScheduleSlotReasonBeforeAnchor},
+			{ScheduleId: 1, Time: date().Add(time.Hour * 21), Kept: true},
+			{ScheduleId: 1, Time: date().Add(time.Hour * 26), Reason: aggregate.ScheduleSlotReasonNight},
+		}, explain.getDecisions())
+
+		timetable = makeTimetable(ctx, schedule, testNow.AddDate(0, 0, 1), testConfig.BeginDayHour, testConfig.EndDayHour, testConfig.TimeRound, nil)
+		require.Equal(t, []string{"11:00:00", "16:00:00", "21:00:00"}, timetable.ToStringArray())
+	})
+
+	t.Run("record intake", func(t *testing.T) {
+		ctx := contextx.WithLocation(context.Background(), time.UTC)
+
+		repo := memory.NewScheduleRepo(nil)
+		uc := NewUsecase(repo, testConfig, ClockFunc(func() time.Time { return testNow }), nil)
+
+		id, _, err := uc.Create(ctx, &aggregate.ScheduleWithDuration{UserId: testUser, Name: "Test", Period: value.SchedulePeriod(time.Hour * 5)})
+		require.NoError(t, err)
+
+		timetable, err := uc.GetTimetable(ctx, testUser, id)
+		require.NoError(t, err)
+		require.Nil(t, timetable.AnchorAt)
+		require.Equal(t, []string{"08:00:00", "13:00:00", "18:00:00"}, timetable.Timetable.ToStringArray())
+
+		timetable, err = uc.RecordIntake(ctx, testUser, id, date().Add(time.Hour*10+time.Minute*30))
+		require.NoError(t, err, "first intake anchors schedule")
+		require.Equal(t, util.Ptr(date().Add(time.Hour*10+time.Minute*30)), timetable.AnchorAt)
+		require.Equal(t, []string{"10:30:00", "15:30:00", "20:30:00"}, timetable.Timetable.ToStringArray())
+
+		timetable, err = uc.RecordIntake(ctx, testUser, id, date().Add(time.Hour*11))
+		require.NoError(t, err, "late intake without re-anchoring")
+		require.Equal(t, []string{"10:30:00", "15:30:00", "20:30:00"}, timetable.Timetable.ToStringArray())
+
+		_, err = uc.Update(ctx, testUser, id, &aggregate.ScheduleUpdate{Reanchor: util.Ptr(true)})
+		require.NoError(t, err)
+
+		timetable, err = uc.RecordIntake(ctx, testUser, id, date().Add(time.Hour*10+time.Minute*40))
+		require.NoError(t, err, "intake in tolerance")
+		require.Equal(t, []string{"10:30:00", "15:30:00", "20:30:00"}, timetable.Timetable.ToStringArray())
+
+		timetable, err = uc.RecordIntake(ctx, testUser, id, date().Add(time.Hour*11))
+		require.NoError(t, err, "late intake")
+		require.True(t, timetable.Reanchor)
+		require.Equal(t, util.Ptr(date().Add(time.Hour*11)), timetable.AnchorAt)
+		require.Equal(t, []string{"11:00:00", "16:00:00", "21:00:00"}, timetable.Timetable.ToStringArray())
+
+		timetable, err = uc.RecordIntake(ctx, testUser, id, time.Time{})
+		require.NoError(t, err, "late intake now")
+		require.Equal(t, util.Ptr(testNow), timetable.AnchorAt)
+
+		timetable, err = uc.RecordIntake(ctx, testUser, id, testNow.Add(-time.Minute*30))
+		require.NoError(t, err, "early intake")
+		require.Equal(t, util.Ptr(testNow), timetable.AnchorAt)
+
+		_, err = uc.RecordIntake(ctx, testUser, id, testNow.Add(time.Hour))
+		require.ErrorAs(t, err, new(failure.InvalidRequestError))
+
+		intakes, err := uc.GetIntakes(ctx, testUser)
+		require.NoError(t, err)
+		require.Len(t, intakes, 6, "every intake is recorded, rejected one is not")
+		require.Equal(t, testNow, intakes[len(intakes)-1].TakenAt)
+
+		_, err = uc.Update(ctx, testUser, id, &aggregate.ScheduleUpdate{AnchorAt: util.Ptr(date().Add(time.Hour * 8))})
+		require.NoError(t, err)
+
+		timetable, err = uc.GetTimetable(ctx, testUser, id)
+		require.NoError(t, err)
+		require.Equal(t, []string{"08:00:00", "13:00:00", "18:00:00"}, timetable.Timetable.ToStringArray())
+	})
+
+	t.Run("far anchor", func(t *testing.T) {
+		far := testNow.Add(entity.MaxAnchorShift + time.Hour)
+		past := testNow.Add(-entity.MaxAnchorShift - time.Hour)
+
+		for _, anchorAt := range []time.Time{far, past} {
+			err := aggregate.ScheduleWithDuration{UserId: testUser, Name: "Test", Period: entity.MinSchedulePeriod, AnchorAt: &anchorAt}.Validate(testNow)
+			require.ErrorAs(t, err, new(failure.InvalidRequestError), "anchor %s", anchorAt)
+			require.Equal(t, "anchor_at", failure.GetViolations(err)[0].Field)
+
+			require.ErrorAs(t, aggregate.ScheduleUpdate{AnchorAt: &anchorAt}.Validate(testNow), new(failure.InvalidRequestError), "anchor %s", anchorAt)
+		}
+
+		near := testNow.AddDate(0, 11, 0)
+		require.NoError(t, aggregate.ScheduleWithDuration{UserId: testUser, Name: "Test", Period: entity.MinSchedulePeriod, AnchorAt: &near}.Validate(testNow))
+		require.NoError(t, aggregate.ScheduleUpdate{AnchorAt: &near}.Validate(testNow))
+
+		ctx := contextx.WithLocation(context.Background(), time.UTC)
+		uc := NewUsecase(memory.NewScheduleRepo(nil), testConfig, ClockFunc(func() time.Time { return testNow }), nil)
+
+		id, _, err := uc.Create(ctx, &aggregate.ScheduleWithDuration{UserId: testUser, Name: "Test", Period: value.SchedulePeriod(time.Hour * 5)})
+		require.NoError(t, err)
+
+		_, err = uc.RecordIntake(ctx, testUser, id, past)
+		require.ErrorAs(t, err, new(failure.InvalidRequestError))
+	})
+}
+
+func TestTimesPerDay(t *testing.T) {
+	cases := []struct {
+		TimesPerDay int
+		Slots       []string
+		Period      value.SchedulePeriod
+	}{
+		{TimesPerDay: 1, Slots: []string{"08:00:00"}, Period: entity.MaxSchedulePeriod},
+		{TimesPerDay: 2, Slots: []string{"08:00:00", "22:00:00"}, Period: value.SchedulePeriod(time.Hour * 14)},
+		{TimesPerDay: 3, Slots: []string{"08:00:00", "15:00:00", "22:00:00"}, Period: value.SchedulePeriod(time.Hour * 7)},
+		{TimesPerDay: 4, Slots: []string{"08:00:00", "12:45:00", "17:15:00", "22:00:00"}, Period: value.SchedulePeriod(time.Hour*4 + time.Minute*40)},
+	}
+
+	for _, c := range cases {
+		slots, period := newScheduleDaySlots(c.TimesPerDay, testConfig.BeginDayHour, testConfig.EndDayHour, testConfig.TimeRound)
+		require.Equal(t, c.Slots, slots.ToStringArray(), "times per day %d", c.TimesPerDay)
+		require.Equal(t, c.Period, period, "times per day %d", c.TimesPerDay)
+		require.Empty(t, getScheduleWarnings(&entity.Schedule{DaySlots: slots, Period: period}))
+	}
+
+	slots, period := newScheduleDaySlots(20, testConfig.BeginDayHour, testConfig.EndDayHour, testConfig.TimeRound)
+	require.Len(t, slots, 20)
+	require.Equal(t, []aggregate.ScheduleWarning{aggregate.ScheduleWarningShortInterval}, getScheduleWarnings(&entity.Schedule{DaySlots: slots, Period: period}))
+	require.Equal(t, []aggregate.ScheduleWarning{aggregate.ScheduleWarningShortInterval}, getScheduleWarnings(&entity.Schedule{
+		DaySlots: value.ScheduleDaySlots{time.Hour * 8, time.Hour*8 + time.Minute*45},
+		Period:   value.SchedulePeriod(time.Hour),
+	}), "rounded interval is short")
+
+	t.Run("slots are stored", func(t *testing.T) {
+		ctx := contextx.WithLocation(context.Background(), time.FixedZone("", 2*60*60))
+
+		repo := memory.NewScheduleRepo(nil)
+		uc := NewUsecase(repo, testConfig, ClockFunc(func() time.Time { return testNow }), nil)
+
+		id, warnings, err := uc.Create(ctx, &aggregate.ScheduleWithDuration{UserId: testUser, Name: "Test", TimesPerDay: 3})
+		require.NoError(t, err)
+		require.Empty(t, warnings)
+
+		timetable, err := uc.GetTimetable(ctx, testUser, id)
+		require.NoError(t, err)
+		require.Equal(t, 3, timetable.TimesPerDay)
+		require.Empty(t, timetable.Warnings)
+		require.Equal(t, value.SchedulePeriod(time.Hour*7), timetable.Period)
+		require.Equal(t, []string{"08:00:00", "15:00:00", "22:00:00"}, timetable.Timetable.ToStringArray())
+
+		cfg := testConfig
+		cfg.BeginDayHour, cfg.EndDayHour, cfg.TimeRound = 9, 21, time.Hour
+		uc = NewUsecase(repo, cfg, ClockFunc(func() time.Time { return testNow }), nil)
+
+		timetable, err = uc.GetTimetable(ctx, testUser, id)
+		require.NoError(t, err)
+		require.Equal(t, []string{"08:00:00", "15:00:00", "22:00:00"}, timetable.Timetable.ToStringArray(), "slots do not shift when config changes")
+
+		nextTakings, err := uc.GetNextTakings(contextx.WithLocation(ctx, time.UTC), testUser)
+		require.NoError(t, err)
+		require.Empty(t, nextTakings, "next taking is at 15:00 UTC, 3 hours after now")
+
+		nextTakings, err = uc.GetNextTakings(ctx, testUser)
+		require.NoError(t, err)
+		require.Len(t, nextTakings, 1)
+		require.Equal(t, "15:00:00", nextTakings[0].NextTaking.Format(time.TimeOnly))
+
+		timetable, err = uc.Update(ctx, testUser, id, &aggregate.ScheduleUpdate{TimesPerDay: util.Ptr(20)})
+		require.NoError(t, err)
+		require.Equal(t, 13, timetable.TimesPerDay, "rounded into previous slots with new config")
+		require.Equal(t, []aggregate.ScheduleWarning{aggregate.ScheduleWarningShortInterval}, timetable.Warnings)
+
+		timetable, err = uc.Update(ctx, testUser, id, &aggregate.ScheduleUpdate{Period: util.Ptr(value.SchedulePeriod(time.Hour * 5))})
+		require.NoError(t, err)
+		require.Zero(t, timetable.TimesPerDay)
+		require.Empty(t, timetable.Warnings)
+		require.Equal(t, []string{"09:00:00", "14:00:00", "19:00:00"}, timetable.Timetable.ToStringArray())
+
+		_, err = uc.Update(ctx, testUser, id, &aggregate.ScheduleUpdate{TimesPerDay: util.Ptr(2), RoundTheClock: util.Ptr(true)})
+		require.ErrorAs(t, err, new(failure.InvalidRequestError))
+	})
+
+	t.Run("daylight saving day", func(t *testing.T) {
+		berlin, err := time.LoadLocation("Europe/Berlin")
+		require.NoError(t, err)
+		ctx := contextx.WithLocation(context.Background(), berlin)
+
+		now := time.Date(2025, time.March, 30, 7, 30, 0, 0, berlin) // clocks moved from 02:00 to 03:00
+		uc := NewUsecase(memory.NewScheduleRepo(nil), testConfig, ClockFunc(func() time.Time { return now }), nil)
+
+		id, _, err := uc.Create(ctx, &aggregate.ScheduleWithDuration{UserId: testUser, Name: "Test", TimesPerDay: 3})
+		require.NoError(t, err)
+
+		timetable, err := uc.GetTimetable(ctx, testUser, id)
+		require.NoError(t, err)
+		require.Equal(t, []string{"08:00:00", "15:00:00", "22:00:00"}, timetable.Timetable.ToStringArray())
+
+		nextTakings, err := uc.GetNextTakings(ctx, testUser)
+		require.NoError(t, err)
+		require.Len(t, nextTakings, 1)
+		require.Equal(t, "08:00:00", nextTakings[0].NextTaking.Format(time.TimeOnly))
+	})
+
+	t.Run("warnings on create", func(t *testing.T) {
+		ctx := contextx.WithLocation(context.Background(), time.UTC)
+
+		uc := NewUsecase(memory.NewScheduleRepo(nil), testConfig, ClockFunc(func() time.Time { return testNow }), nil)
+
+		_, warnings, err := uc.Create(ctx, &aggregate.ScheduleWithDuration{UserId: testUser, Name: "Test", TimesPerDay: 20})
+		require.NoError(t, err)
+		require.Equal(t, []aggregate.ScheduleWarning{aggregate.ScheduleWarningShortInterval}, warnings)
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		for _, dto := range []aggregate.ScheduleWithDuration{
+			{UserId: testUser, Name: "Test", TimesPerDay: -1},
+			{UserId: testUser, Name: "Test", TimesPerDay: entity.MaxTimesPerDay + 1},
+			{UserId: testUser, Name: "Test", TimesPerDay: 3, Period: value.SchedulePeriod(time.Hour * 5)},
+			{UserId: testUser, Name: "Test", TimesPerDay: 3, RoundTheClock: true},
+		} {
+			require.ErrorAs(t, dto.Validate(testNow), new(failure.InvalidRequestError), "%+v", dto)
+		}
+		require.NoError(t, aggregate.ScheduleWithDuration{UserId: testUser, Name: "Test", TimesPerDay: entity.MaxTimesPerDay}.Validate(testNow))
+	})
+}
+
+func TestFixedDaySlots(t *testing.T) {
+	ctx := contextx.WithLocation(context.Background(), time.UTC)
+	uc := NewUsecase(memory.NewScheduleRepo(nil), testConfig, ClockFunc(func() time.Time { return testNow }), nil)
+
+	dto := &aggregate.ScheduleWithDuration{UserId: testUser, Name: "Test", DaySlots: value.ScheduleDaySlots{time.Hour * 20, time.Hour * 8, time.Hour * 13}}
+	require.NoError(t, dto.Validate(testNow))
+
+	id, _, err := uc.Create(ctx, dto)
+	require.NoError(t, err)
+
+	timetable, err := uc.GetTimetable(ctx, testUser, id)
+	require.NoError(t, err)
+	require.Equal(t, []string{"08:00:00", "13:00:00", "20:00:00"}, timetable.Timetable.ToStringArray())
+	require.Equal(t, value.SchedulePeriod(time.Hour*5), timetable.Period, "shortest interval")
+	require.Equal(t, 3, timetable.TimesPerDay)
+
+	err = aggregate.ScheduleWithDuration{UserId: testUser, Name: "Test", Period: entity.MinSchedulePeriod, DaySlots: value.ScheduleDaySlots{day}}.Validate(testNow)
+	require.ErrorAs(t, err, new(failure.InvalidRequestError))
+	require.Len(t, failure.GetViolations(err), 2, "day slot and period")
+}
+
+func TestDoseCount(t *testing.T) {
+	ctx := contextx.WithLocation(context.Background(), time.UTC)
+
+	now := testNow
+	repo := memory.NewScheduleRepo(nil)
+	uc := NewUsecase(repo, testConfig, ClockFunc(func() time.Time { return now }), nil)
+
+	t.Run("final dose", func(t *testing.T) {
+		now = testNow
+
+		id, _, err := uc.Create(ctx, &aggregate.ScheduleWithDuration{UserId: testUser, Name: "Test", Period: value.SchedulePeriod(time.Hour * 5), Doses: 5})
+		require.NoError(t, err)
+
+		timetable, err := uc.GetTimetable(ctx, testUser, id)
+		require.NoError(t, err)
+		require.Equal(t, util.Ptr(date().AddDate(0, 0, 1).Add(time.Hour*18)), timetable.FinalDoseAt, "13:00, 18:00 today and 08:00, 13:00, 18:00 tomorrow")
+		require.Equal(t, util.Ptr(5), timetable.RemainingDoses)
+		require.Equal(t, date().AddDate(0, 0, 1).Add(time.Hour*22), timetable.EndAt.ToTime())
+		require.Equal(t, []string{"08:00:00", "13:00:00", "18:00:00"}, timetable.Timetable.ToStringArray())
+
+		now = testNow.AddDate(0, 0, 1)
+		timetable, err = uc.GetTimetable(ctx, testUser, id)
+		require.NoError(t, err)
+		require.Equal(t, util.Ptr(2), timetable.RemainingDoses)
+
+		now = testNow.AddDate(0, 0, 1).Add(time.Hour*5 + time.Minute*30)
+		nextTakings, err := uc.GetNextTakings(ctx, testUser)
+		require.NoError(t, err)
+		require.Len(t, nextTakings, 1)
+		require.Equal(t, date().AddDate(0, 0, 1).Add(time.Hour*18), nextTakings[0].NextTaking.Time)
+
+		now = testNow.AddDate(0, 0, 2)
+		timetable, err = uc.GetTimetable(ctx, testUser, id)
+		require.NoError(t, err)
+		require.Equal(t, util.Ptr(0), timetable.RemainingDoses)
+		require.Empty(t, timetable.Timetable)
+
+		require.NoError(t, uc.Delete(ctx, testUser, id))
+	})
+
+	t.Run("days before anchor are skipped", func(t *testing.T) {
+		now = testNow
+
+		id, _, err := uc.Create(ctx, &aggregate.ScheduleWithDuration{
+			UserId:   testUser,
+			Name:     "Test",
+			Period:   value.SchedulePeriod(time.Hour * 12),
+			AnchorAt: util.Ptr(date().AddDate(0, 0, 2).Add(time.Hour * 10)),
+			Doses:    3,
+		})
+		require.NoError(t, err)
+
+		timetable, err := uc.GetTimetable(ctx, testUser, id)
+		require.NoError(t, err)
+		require.Equal(t, util.Ptr(date().AddDate(0, 0, 3).Add(time.Hour*10)), timetable.FinalDoseAt)
+		require.Equal(t, util.Ptr(3), timetable.RemainingDoses)
+
+		require.NoError(t, uc.Delete(ctx, testUser, id))
+	})
+
+	t.Run("night final dose", func(t *testing.T) {
+		now = testNow
+
+		id, _, err := uc.Create(ctx, &aggregate.ScheduleWithDuration{UserId: testUser, Name: "Test", Period: value.SchedulePeriod(time.Hour * 6), RoundTheClock: true, Doses: 3})
+		require.NoError(t, err)
+
+		now = testNow.Add(time.Hour * 13)
+		timetable, err := uc.GetTimetable(ctx, testUser, id)
+		require.NoError(t, err)
+		require.Equal(t, util.Ptr(date().AddDate(0, 0, 1).Add(time.Hour*2)), timetable.FinalDoseAt, "14:00, 20:00 and 02:00 at night")
+		require.Equal(t, util.Ptr(1), timetable.RemainingDoses)
+
+		require.Equal(t, []string{"02:00:00"}, timetable.Timetable.ToStringArray(), "takings after final dose are cut")
+
+		nextTakings, err := uc.GetNextTakings(ctx, testUser)
+		require.NoError(t, err)
+		require.Len(t, nextTakings, 1)
+		require.Equal(t, date().AddDate(0, 0, 1).Add(time.Hour*2), nextTakings[0].NextTaking.Time)
+		require.True(t, nextTakings[0].Night)
+
+		require.NoError(t, uc.Delete(ctx, testUser, id))
+	})
+
+	t.Run("update keeps remaining doses", func(t *testing.T) {
+		now = testNow
+
+		id, _, err := uc.Create(ctx, &aggregate.ScheduleWithDuration{UserId: testUser, Name: "Test", Period: value.SchedulePeriod(time.Hour * 5), Doses: 5})
+		require.NoError(t, err)
+
+		timetable, err := uc.Update(ctx, testUser, id, &aggregate.ScheduleUpdate{Period: util.Ptr(value.SchedulePeriod(time.Hour * 4))})
+		require.NoError(t, err)
+		require.Equal(t, util.Ptr(date().AddDate(0, 0, 1).Add(time.Hour*16)), timetable.FinalDoseAt, "16:00, 20:00 today and 08:00, 12:00, 16:00 tomorrow")
+		require.Equal(t, util.Ptr(5), timetable.RemainingDoses)
+
+		timetable, err = uc.Update(ctx, testUser, id, &aggregate.ScheduleUpdate{Doses: util.Ptr(1)})
+		require.NoError(t, err)
+		require.Equal(t, util.Ptr(date().Add(time.Hour*16)), timetable.FinalDoseAt)
+		require.Equal(t, []string{"08:00:00", "12:00:00", "16:00:00"}, timetable.Timetable.ToStringArray())
+
+		timetable, err = uc.Update(ctx, testUser, id, &aggregate.ScheduleUpdate{Duration: util.Ptr(value.ScheduleDuration(3))})
+		require.NoError(t, err)
+		require.Nil(t, timetable.FinalDoseAt)
+		require.Nil(t, timetable.RemainingDoses)
+		require.Equal(t, date().AddDate(0, 0, 3).Add(time.Hour*22), timetable.EndAt.ToTime())
+
+		_, err = uc.Update(ctx, testUser, id, &aggregate.ScheduleUpdate{Doses: util.Ptr(2)})
+		require.NoError(t, err)
+		timetable, err = uc.Update(ctx, testUser, id, &aggregate.ScheduleUpdate{Doses: util.Ptr(0)})
+		require.NoError(t, err)
+		require.Nil(t, timetable.FinalDoseAt)
+		require.True(t, timetable.EndAt.IsNil())
+
+		require.NoError(t, uc.Delete(ctx, testUser, id))
+	})
+
+	t.Run("intake moves remaining doses", func(t *testing.T) {
+		now = testNow
+
+		id, _, err := uc.Create(ctx, &aggregate.ScheduleWithDuration{UserId: testUser, Name: "Test", Period: value.SchedulePeriod(time.Hour * 5), Doses: 3})
+		require.NoError(t, err)
+
+		timetable, err := uc.GetTimetable(ctx, testUser, id)
+		require.NoError(t, err)
+		require.Equal(t, util.Ptr(date().AddDate(0, 0, 1).Add(time.Hour*8)), timetable.FinalDoseAt)
+
+		timetable, err = uc.RecordIntake(ctx, testUser, id, time.Time{})
+		require.NoError(t, err)
+		require.Equal(t, util.Ptr(date().AddDate(0, 0, 1).Add(time.Hour*12)), timetable.FinalDoseAt, "17:00, 22:00 today and 12:00 tomorrow")
+		require.Equal(t, util.Ptr(3), timetable.RemainingDoses)
+
+		require.NoError(t, uc.Delete(ctx, testUser, id))
+	})
+
+	t.Run("not enough takings", func(t *testing.T) {
+		now = testNow
+
+		_, _, err := uc.Create(ctx, &aggregate.ScheduleWithDuration{
+			UserId:   testUser,
+			Name:     "Test",
+			Period:   entity.MaxSchedulePeriod,
+			AnchorAt: util.Ptr(date().Add(time.Hour * 23)),
+			Doses:    1,
+		})
+		require.ErrorAs(t, err, new(failure.InvalidRequestError), "anchor is outside of day window")
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		for _, dto := range []aggregate.ScheduleWithDuration{
+			{UserId: testUser, Name: "Test", Period: entity.MinSchedulePeriod, Doses: -1},
+			{UserId: testUser, Name: "Test", Period: entity.MinSchedulePeriod, Doses: entity.MaxScheduleDoses + 1},
+			{UserId: testUser, Name: "Test", Period: entity.MinSchedulePeriod, Doses: 10, Duration: 5},
+		} {
+			require.ErrorAs(t, dto.Validate(testNow), new(failure.InvalidRequestError), "%+v", dto)
+		}
+		require.ErrorAs(t, aggregate.ScheduleUpdate{Doses: util.Ptr(10), Duration: util.Ptr(value.ScheduleDuration(5))}.Validate(testNow), new(failure.InvalidRequestError))
+		require.NoError(t, aggregate.ScheduleWithDuration{UserId: testUser, Name: "Test", Period: entity.MinSchedulePeriod, Doses: entity.MaxScheduleDoses}.Validate(testNow))
+	})
+}
+
+func TestExplainTimetable(t *testing.T) {
+	ctx := contextx.WithLocation(context.Background(), time.UTC)
+
+	schedule := &entity.Schedule{Id: 1, Period: value.SchedulePeriod(time.Hour)}
+
+	explain := new(explanation)
+	resp := makeTimetable(ctx, schedule, testNow, 8, 12, time.Hour*2, explain)
+
+	require.Equal(t, value.ScheduleTimeTable{
+		value.NewScheduleTimeTableItem(date().Add(time.Hour * 8)),
+		value.NewScheduleTimeTableItem(date().Add(time.Hour * 10)),
+		value.NewScheduleTimeTableItem(date().Add(time.Hour * 12)),
+	}, resp)
+
+	require.Equal(t, []aggregate.ScheduleSlotDecision{
+		{ScheduleId: 1, Time: date().Add(time.Hour * 8), Kept: true},
+		{ScheduleId: 1, Time: date().Add(time.Hour * 10), Kept: true},
+		{ScheduleId: 1, Time: date().Add(time.Hour * 10), Reason: aggregate.ScheduleSlotReasonRounded},
+		{ScheduleId: 1, Time: date().Add(time.Hour * 12), Kept: true},
+		{ScheduleId: 1, Time: date().Add(time.Hour * 12), Reason: aggregate.ScheduleSlotReasonRounded},
+		{ScheduleId: 1, Time: date().Add(time.Hour * 14), Reason: aggregate.ScheduleSlotReasonNight},
+	}, explain.getDecisions())
+}
+
+func TestExplainNextTakings(t *testing.T) {
+	hourly := &entity.Schedule{
+		Id:     1,
+		EndAt:  value.NewScheduleEndAt(util.Ptr(date().Add(time.Hour * 13))),
+		Period: value.SchedulePeriod(time.Hour),
+	}
+	fiveHourly := &entity.Schedule{
+		Id:     2,
+		Period: value.SchedulePeriod(time.Hour * 5),
+	}
+
+	testCases := []struct {
+		Name             string
+		Now              time.Time
+		NextTakingPeriod time.Duration
+		Schedules        []*entity.Schedule
+		Expected         []aggregate.ScheduleSlotDecision
+	}{
+		{
+			Name:             "expired and outside period",
+			Now:              testNow,
+			NextTakingPeriod: testConfig.NextTakingPeriod,
+			Schedules:        []*entity.Schedule{hourly, fiveHourly},
+			Expected: []aggregate.ScheduleSlotDecision{
+				{ScheduleId: 1, Time: date().Add(time.Hour * 8), Reason: aggregate.ScheduleSlotReasonPassed},
+				{ScheduleId: 1, Time: date().Add(time.Hour * 9), Reason: aggregate.ScheduleSlotReasonPassed},
+				{ScheduleId: 1, Time: date().Add(time.Hour * 10), Reason: aggregate.ScheduleSlotReasonPassed},
+				{ScheduleId: 1, Time: date().Add(time.Hour * 11), Reason: aggregate.ScheduleSlotReasonPassed},
+				{ScheduleId: 1, Time: date().Add(time.Hour * 12), Reason: aggregate.ScheduleSlotReasonPassed},
+				{ScheduleId: 1, Time: date().Add(time.Hour * 13), Kept: true},
+				{ScheduleId: 1, Time: date().Add(time.Hour * 14), Reason: aggregate.ScheduleSlotReasonExpired},
+				{ScheduleId: 2, Time: date().Add(time.Hour * 8), Reason: aggregate.ScheduleSlotReasonPassed},
+				{ScheduleId: 2, Time: date().Add(time.Hour * 13), Kept: true},
+				{ScheduleId: 2, Time: date().Add(time.Hour * 18), Reason: aggregate.ScheduleSlotReasonOutsidePeriod},
+			},
+		},
+		{
+			Name:             "night",
+			Now:              date().Add(time.Hour * 20),
+			NextTakingPeriod: time.Hour * 13, // until 9:00 next day
+			Schedules:        []*entity.Schedule{fiveHourly},
+			Expected: []aggregate.ScheduleSlotDecision{
+				{ScheduleId: 2, Time: date().Add(time.Hour * 8), Reason: aggregate.ScheduleSlotReasonPassed},
+				{ScheduleId: 2, Time: date().Add(time.Hour * 13), Reason: aggregate.ScheduleSlotReasonPassed},
+				{ScheduleId: 2, Time: date().Add(time.Hour * 18), Reason: aggregate.ScheduleSlotReasonPassed},
+				{ScheduleId: 2, Time: date().Add(time.Hour * 23), Reason: aggregate.ScheduleSlotReasonNight},
+				{ScheduleId: 2, Time: date().Add(day + time.Hour*8), Kept: true},
+				{ScheduleId: 2, Time: date().Add(day + time.Hour*13), Reason: aggregate.ScheduleSlotReasonOutsidePeriod},
+			},
+		},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.Name, func(t *testing.T) {
+			ctx := contextx.WithLocation(context.Background(), time.UTC)
+
+			explain := new(explanation)
+			findNextTakings(ctx, c.Schedules, c.Now, c.NextTakingPeriod, testConfig.BeginDayHour, testConfig.EndDayHour, testConfig.TimeRound, explain)
+
+			require.Equal(t, c.Expected, explain.getDecisions())
+		})
+	}
+}
+
+func TestGetDayPlan(t *testing.T) {
+	ctx := contextx.WithLocation(context.Background(), time.UTC)
+
+	repo := memory.NewScheduleRepo(nil)
+	for _, schedule := range []*entity.Schedule{
+		{UserId: testUser, Name: "A", Period: value.SchedulePeriod(time.Hour * 5)},
+		{UserId: testUser, Name: "B", Period: value.SchedulePeriod(time.Hour * 12)},
+		{UserId: testUser, Name: "C", Period: value.SchedulePeriod(time.Hour * 4), EndAt: value.NewScheduleEndAt(util.Ptr(date()))},
+		{UserId: testUser, Name: "D", Period: value.SchedulePeriod(time.Hour), EndAt: value.NewScheduleEndAt(util.Ptr(date().Add(-day)))},
+	} {
+		require.NoError(t, repo.Save(ctx, schedule))
+	}
+
+	uc := NewUsecase(repo, testConfig, ClockFunc(func() time.Time { return testNow }), nil)
+
+	slot := func(d time.Time, hour int, doses ...aggregate.DayPlanDose) aggregate.DayPlanSlot {
+		t := value.NewScheduleTimeTableItem(d.Add(time.Hour * time.Duration(hour)))
+		for i := range doses {
+			doses[i].PrescribedTime = t
+		}
+		return aggregate.DayPlanSlot{
+			Time:  t,
+			Doses: doses,
+		}
+	}
+	a := aggregate.DayPlanDose{ScheduleId: 1, Name: "A"}
+	b := aggregate.DayPlanDose{ScheduleId: 2, Name: "B"}
+	c := aggregate.DayPlanDose{ScheduleId: 3, Name: "C"}
+
+	t.Run("today", func(t *testing.T) {
+		plan, err := uc.GetDayPlan(ctx, testUser, time.Time{})
+		require.NoError(t, err)
+
+		require.Equal(t, &aggregate.DayPlan{
+			Date: date(),
+			Slots: []aggregate.DayPlanSlot{
+				slot(date(), 8, a, b, c),
+				slot(date(), 12, c),
+				slot(date(), 13, a),
+				slot(date(), 16, c),
+				slot(date(), 18, a),
+				slot(date(), 20, b, c),
+			},
+		}, plan)
+	})
+
+	t.Run("tomorrow", func(t *testing.T) {
+		tomorrow := date().AddDate(0, 0, 1)
+
+		plan, err := uc.GetDayPlan(ctx, testUser, tomorrow)
+		require.NoError(t, err)
+
+		require.Equal(t, &aggregate.DayPlan{
+			Date: tomorrow,
+			Slots: []aggregate.DayPlanSlot{
+				slot(tomorrow, 8, a, b),
+				slot(tomorrow, 13, a),
+				slot(tomorrow, 18, a),
+				slot(tomorrow, 20, b),
+			},
+		}, plan)
+	})
+
+	t.Run("taken", func(t *testing.T) {
+		tomorrow := date().AddDate(0, 0, 1)
+		for _, intake := range []*entity.Intake{
+			{ScheduleId: 1, UserId: testUser, Name: "A", TakenAt: tomorrow.Add(time.Hour*8 + time.Minute*5)},
+			{ScheduleId: 1, UserId: testUser, Name: "A", TakenAt: tomorrow.Add(time.Hour*13 + time.Minute*40)},
+			{ScheduleId: 2, UserId: testUser, Name: "B", TakenAt: tomorrow.Add(-time.Hour)},
+		} {
+			require.NoError(t, repo.SaveIntake(ctx, intake))
+		}
+
+		plan, err := uc.GetDayPlan(ctx, testUser, tomorrow)
+		require.NoError(t, err)
+
+		taken := func(dose aggregate.DayPlanDose, at time.Time, late bool) aggregate.DayPlanDose {
+			dose.TakenAt, dose.Late = &at, late
+			return dose
+		}
+		require.Equal(t, &aggregate.DayPlan{
+			Date: tomorrow,
+			Slots: []aggregate.DayPlanSlot{
+				slot(tomorrow, 8, taken(a, tomorrow.Add(time.Hour*8+time.Minute*5), false), b),
+				slot(tomorrow, 13, taken(a, tomorrow.Add(time.Hour*13+time.Minute*40), true)),
+				slot(tomorrow, 18, a),
+				slot(tomorrow, 20, b),
+			},
+		}, plan, "intake of other day is not matched")
+	})
+}
+
+func TestConsolidatedDayPlan(t *testing.T) {
+	ctx := contextx.WithLocation(context.Background(), time.UTC)
+
+	repo := memory.NewScheduleRepo(nil)
+	for _, schedule := range []*entity.Schedule{
+		{UserId: testUser, Name: "A", Period: value.SchedulePeriod(time.Hour * 5)},                // 08:00 13:00 18:00
+		{UserId: testUser, Name: "B", Period: value.SchedulePeriod(time.Hour*4 + time.Minute*30)}, // 08:00 12:30 17:00 21:30
+		{UserId: testUser, Name: "C", Period: value.SchedulePeriod(time.Hour * 4)},                // 08:00 12:00 16:00 20:00
+		{UserId: testUser, Name: "D", Period: value.SchedulePeriod(time.Hour * 12)},               // 08:00 20:00
+		{UserId: testUser + 1, Name: "Other", Period: value.SchedulePeriod(time.Hour * 7)},        // other user
+	} {
+		require.NoError(t, repo.Save(ctx, schedule))
+	}
+
+	uc := NewUsecase(repo, testConfig, ClockFunc(func() time.Time { return testNow }), nil)
+
+	at := func(hour, minute int) value.ScheduleTimeTableItem {
+		return value.NewScheduleTimeTableItem(date().Add(time.Hour*time.Duration(hour) + time.Minute*time.Duration(minute)))
+	}
+	dose := func(id value.ScheduleId, name value.ScheduleName, prescribed value.ScheduleTimeTableItem) aggregate.DayPlanDose {
+		return aggregate.DayPlanDose{ScheduleId: id, Name: name, PrescribedTime: prescribed}
+	}
+
+	consolidated := &aggregate.DayPlan{
+		Date: date(),
+		Slots: []aggregate.DayPlanSlot{
+			{Time: at(8, 0), Doses: []aggregate.DayPlanDose{dose(1, "A", at(8, 0)), dose(2, "B", at(8, 0)), dose(3, "C", at(8, 0)), dose(4, "D", at(8, 0))}},
+			{Time: at(12, 30), Doses: []aggregate.DayPlanDose{dose(1, "A", at(13, 0)), dose(2, "B", at(12, 30)), dose(3, "C", at(12, 0))}},
+			{Time: at(16, 30), Doses: []aggregate.DayPlanDose{dose(2, "B", at(17, 0)), dose(3, "C", at(16, 0))}},
+			{Time: at(18, 0), Doses: []aggregate.DayPlanDose{dose(1, "A", at(18, 0))}},
+			{Time: at(20, 0), Doses: []aggregate.DayPlanDose{dose(3, "C", at(20, 0)), dose(4, "D", at(20, 0))}},
+			{Time: at(21, 30), Doses: []aggregate.DayPlanDose{dose(2, "B", at(21, 30))}},
+		},
+		Consolidated: true,
+	}
+
+	t.Run("preview", func(t *testing.T) {
+		plan, err := uc.PreviewConsolidatedDayPlan(ctx, testUser, time.Time{})
+		require.NoError(t, err)
+		require.Equal(t, consolidated, plan)
+
+		// doses of each schedule stay in tolerance and apart by min period
+		last := make(map[value.ScheduleId]time.Time)
+		for _, slot := range plan.Slots {
+			for _, d := range slot.Doses {
+				require.LessOrEqual(t, absDuration(slot.Time.Sub(d.PrescribedTime.Time)), testConfig.ConsolidationTolerance)
+				if prev, ok := last[d.ScheduleId]; ok {
+					require.GreaterOrEqual(t, slot.Time.Sub(prev), time.Duration(entity.MinSchedulePeriod))
+				}
+				last[d.ScheduleId] = slot.Time.Time
+			}
+		}
+	})
+
+	t.Run("disabled in settings", func(t *testing.T) {
+		plan, err := uc.GetDayPlan(ctx, testUser, time.Time{})
+		require.NoError(t, err)
+		require.False(t, plan.Consolidated)
+		require.Len(t, plan.Slots, 9)
+
+		nextTakings, err := uc.GetNextTakings(ctx, testUser)
+		require.NoError(t, err)
+		require.Len(t, nextTakings, 2)
+		require.Equal(t, at(12, 30).Time, nextTakings[0].NextTaking.Time)
+		require.Equal(t, at(13, 0).Time, nextTakings[1].NextTaking.Time)
+	})
+
+	t.Run("enabled in settings", func(t *testing.T) {
+		require.NoError(t, uc.UpdateSettings(ctx, &entity.UserSettings{UserId: testUser, ConsolidateReminders: true}))
+
+		settings, err := uc.GetSettings(ctx, testUser)
+		require.NoError(t, err)
+		require.True(t, settings.ConsolidateReminders)
+
+		plan, err := uc.GetDayPlan(ctx, testUser, time.Time{})
+		require.NoError(t, err)
+		require.Equal(t, consolidated, plan)
+
+		nextTakings, err := uc.GetNextTakings(ctx, testUser)
+		require.NoError(t, err)
+		require.Len(t, nextTakings, 2)
+		for _, nextTaking := range nextTakings {
+			require.Equal(t, at(12, 30).Time, nextTaking.NextTaking.Time)
+		}
+	})
+}
+
+func TestConsolidationTolerance(t *testing.T) {
+	ctx := contextx.WithLocation(context.Background(), time.UTC)
+
+	at := func(hour int) value.ScheduleTimeTableItem {
+		return value.NewScheduleTimeTableItem(date().Add(time.Hour * time.Duration(hour)))
+	}
+	slotTimes := func(plan *aggregate.DayPlan) []value.ScheduleTimeTableItem {
+		times := make([]value.ScheduleTimeTableItem, len(plan.Slots))
+		for i, slot := range plan.Slots {
+			times[i] = slot.Time
+		}
+		return times
+	}
+
+	testCases := []struct {
+		name      string
+		tolerance *time.Duration
+		expected  []value.ScheduleTimeTableItem
+	}{
+		{
+			name:     "default",
+			expected: []value.ScheduleTimeTableItem{at(8), at(13), at(14), at(18), at(20)},
+		},
+		{
+			name:      "own",
+			tolerance: util.Ptr(time.Hour),
+			expected:  []value.ScheduleTimeTableItem{at(8), at(13), at(18), at(20)},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := memory.NewScheduleRepo(nil)
+			for _, schedule := range []*entity.Schedule{
+				{UserId: testUser, Name: "Insulin", Period: value.SchedulePeriod(time.Hour * 5), ConsolidationTolerance: util.Ptr(time.Minute)}, // 08:00 13:00 18:00
+				{UserId: testUser, Name: "Vitamins", Period: value.SchedulePeriod(time.Hour * 6), ConsolidationTolerance: tc.tolerance},         // 08:00 14:00 20:00
+			} {
+				require.NoError(t, repo.Save(ctx, schedule))
+			}
+
+			uc := NewUsecase(repo, testConfig, ClockFunc(func() time.Time { return testNow }), nil)
+
+			plan, err := uc.PreviewConsolidatedDayPlan(ctx, testUser, time.Time{})
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, slotTimes(plan))
+
+			for _, slot := range plan.Slots {
+				for _, dose := range slot.Doses {
+					if dose.ScheduleId == 1 {
+						require.True(t, dose.PrescribedTime.Equal(slot.Time.Time), "fixed dose is moved to %s", slot.Time)
+					}
+				}
+			}
+		})
+	}
+
+	t.Run("create and update", func(t *testing.T) {
+		uc := NewUsecase(memory.NewScheduleRepo(nil), testConfig, ClockFunc(func() time.Time { return testNow }), nil)
+
+		for _, tolerance := range []time.Duration{-time.Minute, entity.MaxConsolidationTolerance + time.Minute} {
+			err := aggregate.ScheduleWithDuration{UserId: testUser, Name: "Test", Period: entity.MinSchedulePeriod, ConsolidationTolerance: tolerance}.Validate(testNow)
+			require.ErrorAs(t, err, new(failure.InvalidRequestError), "tolerance %s", tolerance)
+			require.Equal(t, "consolidation_tolerance", failure.GetViolations(err)[0].Field)
+
+			require.ErrorAs(t, aggregate.ScheduleUpdate{ConsolidationTolerance: &tolerance}.Validate(testNow), new(failure.InvalidRequestError), "tolerance %s", tolerance)
+		}
+
+		id, _, err := uc.Create(ctx, &aggregate.ScheduleWithDuration{UserId: testUser, Name: "Test", Period: value.SchedulePeriod(time.Hour * 5), ConsolidationTolerance: time.Hour})
+		require.NoError(t, err)
+
+		timetable, err := uc.GetTimetable(ctx, testUser, id)
+		require.NoError(t, err)
+		require.Equal(t, util.Ptr(time.Hour), timetable.ConsolidationTolerance)
+
+		_, err = uc.Update(ctx, testUser, id, &aggregate.ScheduleUpdate{ConsolidationTolerance: util.Ptr(time.Duration(0))})
+		require.NoError(t, err)
+
+		timetable, err = uc.GetTimetable(ctx, testUser, id)
+		require.NoError(t, err)
+		require.Nil(t, timetable.ConsolidationTolerance, "zero returns to default")
+	})
+}
+
+func TestConsolidatedNextTakingsNotPassed(t *testing.T) {
+	ctx := contextx.WithLocation(context.Background(), time.UTC)
+
+	repo := memory.NewScheduleRepo(nil)
+	for _, schedule := range []*entity.Schedule{
+		{UserId: testUser, Name: "A", Period: value.SchedulePeriod(time.Hour * 8), AnchorAt: util.Ptr(date().Add(time.Hour*8 + time.Minute*15))}, // 08:15 16:15
+		{UserId: testUser, Name: "B", Period: value.SchedulePeriod(time.Hour * 8)},                                                               // 08:00 16:00
+	} {
+		require.NoError(t, repo.Save(ctx, schedule))
+	}
+	require.NoError(t, repo.SaveSettings(ctx, &entity.UserSettings{UserId: testUser, ConsolidateReminders: true}))
+
+	now := date().Add(time.Hour*8 + time.Minute*10)
+	uc := NewUsecase(repo, testConfig, ClockFunc(func() time.Time { return now }), nil)
+
+	plan, err := uc.GetDayPlan(ctx, testUser, time.Time{})
+	require.NoError(t, err)
+	require.Equal(t, date().Add(time.Hour*8), plan.Slots[0].Time.Time, "08:15 is consolidated into 08:00")
+	require.Len(t, plan.Slots[0].Doses, 2)
+
+	nextTakings, err := uc.GetNextTakings(ctx, testUser)
+	require.NoError(t, err)
+	require.Len(t, nextTakings, 1)
+	require.Equal(t, date().Add(time.Hour*8+time.Minute*15), nextTakings[0].NextTaking.Time, "passed slot is not used")
+}
+
+func TestPreview(t *testing.T) {
+	uc := NewUsecase(nil, testConfig, ClockFunc(func() time.Time { return testNow }), nil) // preview must not use repo
+
+	dayTimetable := func(days int) value.ScheduleTimeTable {
+		return value.ScheduleTimeTable{
+			value.NewScheduleTimeTableItem(date().AddDate(0, 0, days).Add(time.Hour * 8)),
+			value.NewScheduleTimeTableItem(date().AddDate(0, 0, days).Add(time.Hour * 13)),
+			value.NewScheduleTimeTableItem(date().AddDate(0, 0, days).Add(time.Hour * 18)),
+		}
+	}
+
+	testCases := []struct {
+		Name     string
+		Schedule aggregate.ScheduleWithDuration
+		Days     int
+		Expected *aggregate.SchedulePreview
+		IsErr    bool
+	}{
+		{
+			Name:     "until end of schedule",
+			Schedule: aggregate.ScheduleWithDuration{UserId: testUser, Name: "Test", Duration: 1, Period: value.SchedulePeriod(time.Hour * 5)},
+			Days:     3,
+			Expected: &aggregate.SchedulePreview{
+				Name:   "Test",
+				EndAt:  value.NewScheduleEndAt(util.Ptr(date().AddDate(0, 0, 1).Add(time.Hour * 22))),
+				Period: value.SchedulePeriod(time.Hour * 5),
+				Days: []aggregate.SchedulePreviewDay{
+					{Date: date(), Timetable: dayTimetable(0)},
+					{Date: date().AddDate(0, 0, 1), Timetable: dayTimetable(1)},
+				},
+				NextTakings: []value.ScheduleNextTaking{value.NewScheduleNextTaking(date().Add(time.Hour * 13))},
+			},
+		},
+		{
+			Name:     "until final dose",
+			Schedule: aggregate.ScheduleWithDuration{UserId: testUser, Name: "Test", Doses: 4, Period: value.SchedulePeriod(time.Hour * 5)},
+			Days:     3,
+			Expected: &aggregate.SchedulePreview{
+				Name:           "Test",
+				EndAt:          value.NewScheduleEndAt(util.Ptr(date().AddDate(0, 0, 1).Add(time.Hour * 22))),
+				Period:         value.SchedulePeriod(time.Hour * 5),
+				FinalDoseAt:    util.Ptr(date().AddDate(0, 0, 1).Add(time.Hour * 13)),
+				RemainingDoses: util.Ptr(4),
+				Days: []aggregate.SchedulePreviewDay{
+					{Date: date(), Timetable: dayTimetable(0)},
+					{Date: date().AddDate(0, 0, 1), Timetable: dayTimetable(1)[:2]},
+				},
+				NextTakings: []value.ScheduleNextTaking{value.NewScheduleNextTaking(date().Add(time.Hour * 13))},
+			},
+		},
+		{
+			Name:     "default days",
+			Schedule: aggregate.ScheduleWithDuration{UserId: testUser, Name: "Test", Period: value.SchedulePeriod(time.Hour * 5)},
+			Expected: &aggregate.SchedulePreview{
+				Name:   "Test",
+				Period: value.SchedulePeriod(time.Hour * 5),
+				Days: []aggregate.SchedulePreviewDay{
+					{Date: date(), Timetable: dayTimetable(0)},
+				},
+				NextTakings: []value.ScheduleNextTaking{value.NewScheduleNextTaking(date().Add(time.Hour * 13))},
+			},
+		},
+		{
+			Name:     "negative days",
+			Schedule: aggregate.ScheduleWithDuration{UserId: testUser, Name: "Test", Period: value.SchedulePeriod(time.Hour * 5)},
+			Days:     -1,
+			IsErr:    true,
+		},
+		{
+			Name:     "invalid schedule",
+			Schedule: aggregate.ScheduleWithDuration{UserId: testUser, Period: value.SchedulePeriod(time.Hour * 5)},
+			IsErr:    true,
+		},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.Name, func(t *testing.T) {
+			ctx := contextx.WithLocation(context.Background(), time.UTC)
+
+			resp, err := uc.Preview(ctx, &c.Schedule, c.Days)
+			if c.IsErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, c.Expected, resp)
+		})
+	}
+}
+
 func date(loc ...*time.Location) time.Time {
 	usingLoc := time.UTC
 	if len(loc) != 0 {
@@ -376,8 +1342,7 @@ func date(loc ...*time.Location) time.Time {
 			usingLoc = loc[0]
 		}
 	}
-	now := time.Now()
-	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, usingLoc)
+	return time.Date(testNow.Year(), testNow.Month(), testNow.Day(), 0, 0, 0, 0, usingLoc)
 }
 
 func mustParseTimezone(name string) *time.Location {
@@ -387,3 +1352,46 @@ func mustParseTimezone(name string) *time.Location {
 	}
 	return loc
 }
+
+func TestListCursor(t *testing.T) {
+	ctx := contextx.WithLocation(context.Background(), time.UTC)
+
+	repo := memory.NewScheduleRepo(nil)
+	for _, name := range []value.ScheduleName{"Aspirin", "Ibuprofen", "Paracetamol"} {
+		require.NoError(t, repo.Save(ctx, &entity.Schedule{UserId: testUser, Name: name, Period: value.SchedulePeriod(time.Hour)}))
+	}
+
+	uc := NewUsecase(repo, testConfig, ClockFunc(func() time.Time { return testNow }), nil)
+
+	first, err := uc.List(ctx, &aggregate.ScheduleListFilter{UserId: testUser, Sort: aggregate.ScheduleListSortName, Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, first.Schedules, 1)
+	require.NotEmpty(t, first.NextCursor)
+
+	t.Run("same query", func(t *testing.T) {
+		next, err := uc.List(ctx, &aggregate.ScheduleListFilter{UserId: testUser, Sort: aggregate.ScheduleListSortName, Cursor: first.NextCursor, Limit: 2})
+		require.NoError(t, err)
+		require.Len(t, next.Schedules, 2)
+		require.Equal(t, value.ScheduleName("Ibuprofen"), next.Schedules[0].Name)
+	})
+
+	testCases := []struct {
+		name   string
+		filter aggregate.ScheduleListFilter
+	}{
+		{name: "other sort", filter: aggregate.ScheduleListFilter{UserId: testUser, Sort: aggregate.ScheduleListSortId}},
+		{name: "other order", filter: aggregate.ScheduleListFilter{UserId: testUser, Sort: aggregate.ScheduleListSortName, Desc: true}},
+		{name: "other name", filter: aggregate.ScheduleListFilter{UserId: testUser, Sort: aggregate.ScheduleListSortName, NameContains: "ol"}},
+		{name: "other status", filter: aggregate.ScheduleListFilter{UserId: testUser, Sort: aggregate.ScheduleListSortName, Status: aggregate.ScheduleStatusActive}},
+		{name: "other end", filter: aggregate.ScheduleListFilter{UserId: testUser, Sort: aggregate.ScheduleListSortName, EndAtTo: util.Ptr(testNow)}},
+		{name: "other user", filter: aggregate.ScheduleListFilter{UserId: testUser + 1, Sort: aggregate.ScheduleListSortName}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.filter.Cursor = first.NextCursor
+			_, err := uc.List(ctx, &tc.filter)
+			require.ErrorAs(t, err, new(failure.InvalidRequestError))
+		})
+	}
+}
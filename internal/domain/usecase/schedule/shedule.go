@@ -3,66 +3,165 @@ package schedule
 import (
 	"context"
 	"fmt"
+	"go.opentelemetry.io/otel"
 	"schedule/internal/config"
 	"schedule/internal/domain/aggregate"
 	"schedule/internal/domain/entity"
 	"schedule/internal/domain/value"
 	"schedule/internal/util"
 	"schedule/pkg/contextx"
+	"schedule/pkg/errcodes"
+	"schedule/pkg/failure"
+	"slices"
 	"time"
 )
 
 const day = 24 * time.Hour
 
+var tracer = otel.Tracer("schedule/internal/domain/usecase/schedule")
+
 type Repo interface {
 	Save(ctx context.Context, schedule *entity.Schedule) error
+	SaveAll(ctx context.Context, schedules []*entity.Schedule) error // in single transaction
 	GetByUser(ctx context.Context, userId value.UserId) ([]*entity.Schedule, error)
 	GetById(ctx context.Context, userId value.UserId, scheduleId value.ScheduleId) (*entity.Schedule, error)
+	GetOwner(ctx context.Context, scheduleId value.ScheduleId) (value.UserId, error)
+	List(ctx context.Context, query *aggregate.ScheduleListQuery) ([]*entity.Schedule, error)
+	Update(ctx context.Context, schedule *entity.Schedule) error
+	Delete(ctx context.Context, userId value.UserId, scheduleId value.ScheduleId) error
+	GetHistory(ctx context.Context, userId value.UserId, scheduleId value.ScheduleId) ([]*entity.AuditRecord, error)
+	GetSettings(ctx context.Context, userId value.UserId) (*entity.UserSettings, error)
+	SaveSettings(ctx context.Context, settings *entity.UserSettings) error
+	SaveIntake(ctx context.Context, intake *entity.Intake) error
+	GetIntakes(ctx context.Context, userId value.UserId) ([]*entity.Intake, error)                            // ordered by taken at
+	GetIntakesBetween(ctx context.Context, userId value.UserId, from, to time.Time) ([]*entity.Intake, error) // taken at in [from, to), ordered by taken at
 }
 
 type Usecase struct {
-	repo Repo
-	cfg  config.ScheduleConfig
+	repo    Repo
+	cfg     config.ScheduleConfig
+	clock   Clock
+	metrics Metrics
 }
 
-func NewUsecase(repo Repo, cfg config.ScheduleConfig) *Usecase {
-	time.Local = nil
+// NewUsecase makes usecase, clock and metrics may be nil.
+func NewUsecase(repo Repo, cfg config.ScheduleConfig, clock Clock, metrics Metrics) *Usecase {
+	if clock == nil {
+		clock = SystemClock
+	}
+	if metrics == nil {
+		metrics = nopMetrics{}
+	}
 	return &Usecase{
-		repo: repo,
-		cfg:  cfg,
+		repo:    repo,
+		cfg:     cfg,
+		clock:   clock,
+		metrics: metrics,
 	}
 }
 
-func (uc *Usecase) Create(ctx context.Context, dto *aggregate.ScheduleWithDuration) (value.ScheduleId, error) {
+// Create saves schedule, warnings are problems of schedule which do not prevent saving it.
+func (uc *Usecase) Create(ctx context.Context, dto *aggregate.ScheduleWithDuration) (value.ScheduleId, []aggregate.ScheduleWarning, error) {
 	const op = "schedule.Create"
 
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
 	l := contextx.GetLoggerOrDefault(ctx)
 
-	var expiredAt *time.Time
-	if dto.Duration > 0 {
-		expiredAt = util.Ptr(time.Now().Add(time.Duration(dto.Duration) * day))
-	}
+	now := uc.Now(ctx)
 
-	schedule := &entity.Schedule{
-		UserId: dto.UserId,
-		Name:   dto.Name,
-		EndAt:  value.NewScheduleEndAt(expiredAt),
-		Period: dto.Period,
+	schedule := newSchedule(dto, now, uc.cfg)
+	if dto.Doses > 0 {
+		if err := uc.limitDoses(ctx, schedule, now, dto.Doses); err != nil {
+			return 0, nil, err
+		}
 	}
 
 	if err := uc.repo.Save(ctx, schedule); err != nil {
 		l.ErrorContext(ctx, "create schedule error", "err", err)
-		return 0, fmt.Errorf("%s: %w", op, err)
+		return 0, nil, fmt.Errorf("%s: %w", op, err)
 	}
+	uc.metrics.SchedulesCreated(1)
 
 	l.DebugContext(ctx, "create schedule", "schedule", schedule)
 
-	return schedule.Id, nil
+	return schedule.Id, getScheduleWarnings(schedule), nil
+}
+
+func (uc *Usecase) Import(ctx context.Context, rows []aggregate.ScheduleImportRow, mode aggregate.ScheduleImportMode) ([]aggregate.ScheduleImportResult, error) {
+	const op = "schedule.Import"
+
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	l := contextx.GetLoggerOrDefault(ctx)
+
+	now := uc.Now(ctx)
+
+	results := make([]aggregate.ScheduleImportResult, len(rows))
+	schedules := make([]*entity.Schedule, len(rows))
+	hasInvalid := false
+
+	for i, row := range rows {
+		results[i].Line = row.Line
+
+		if row.Err != nil {
+			results[i].Err = row.Err
+			hasInvalid = true
+			continue
+		}
+		if err := row.Schedule.Validate(now); err != nil {
+			results[i].Err = err
+			hasInvalid = true
+			continue
+		}
+
+		schedules[i] = newSchedule(row.Schedule, now, uc.cfg)
+	}
+
+	switch mode {
+	case aggregate.ScheduleImportModePerRow:
+		for i, schedule := range schedules {
+			if schedule == nil {
+				continue
+			}
+			if err := uc.repo.Save(ctx, schedule); err != nil {
+				l.ErrorContext(ctx, "import schedule error", "err", err, "line", rows[i].Line)
+				results[i].Err = err
+				continue
+			}
+			results[i].Id = schedule.Id
+			uc.metrics.SchedulesCreated(1)
+		}
+
+	default:
+		if hasInvalid {
+			l.DebugContext(ctx, "import rejected, has invalid rows")
+			return results, nil
+		}
+
+		if err := uc.repo.SaveAll(ctx, schedules); err != nil {
+			l.ErrorContext(ctx, "import schedules error", "err", err)
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		for i, schedule := range schedules {
+			results[i].Id = schedule.Id
+		}
+		uc.metrics.SchedulesCreated(len(schedules))
+	}
+
+	l.DebugContext(ctx, op, "results", results)
+
+	return results, nil
 }
 
 func (uc *Usecase) GetByUser(ctx context.Context, userId value.UserId) ([]value.ScheduleId, error) {
 	const op = "schedule.GetByUser"
 
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
 	l := contextx.GetLoggerOrDefault(ctx)
 
 	location := contextx.GetLocationOrDefault(ctx)
@@ -75,25 +174,141 @@ func (uc *Usecase) GetByUser(ctx context.Context, userId value.UserId) ([]value.
 
 	uc.setScheduleEndHour(location, schedules)
 
-	now := time.Now().In(location)
+	now := uc.Now(ctx)
 	l.DebugContext(ctx, op, "user time", now)
 
-	ids := getActualSchedulesIds(ctx, schedules)
+	ids := getActualSchedulesIds(ctx, schedules, now)
 
 	l.DebugContext(ctx, op, "schedules", ids)
 
 	return ids, nil
 }
 
+func (uc *Usecase) List(ctx context.Context, filter *aggregate.ScheduleListFilter) (*aggregate.ScheduleList, error) {
+	const op = "schedule.List"
+
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	l := contextx.GetLoggerOrDefault(ctx)
+
+	query := &aggregate.ScheduleListQuery{
+		ScheduleListFilter: *filter,
+	}
+
+	switch {
+	case query.Limit < 0:
+		return nil, failure.NewValidationError(failure.Violation{Field: "limit", Description: "limit must not be negative"})
+	case query.Limit == 0:
+		query.Limit = aggregate.DefaultScheduleListLimit
+	case query.Limit > aggregate.MaxScheduleListLimit:
+		query.Limit = aggregate.MaxScheduleListLimit
+	}
+
+	if query.Cursor != "" {
+		after, err := decodeListCursor(query.Cursor)
+		if err != nil {
+			return nil, failure.NewInvalidRequestErrorWithReason(errcodes.InvalidCursor, "invalid cursor")
+		}
+		if after.Sort != query.Sort || after.Desc != query.Desc || after.Filter != hashListFilter(filter) {
+			return nil, failure.NewInvalidRequestErrorWithReason(errcodes.InvalidCursor, "cursor does not match query")
+		}
+		query.After = after
+	}
+
+	location := contextx.GetLocationOrDefault(ctx)
+	now := uc.Now(ctx)
+	l.DebugContext(ctx, op, "user time", now)
+
+	query.ActiveSince = time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	if now.Hour() >= uc.cfg.EndDayHour { // schedule ends at end of day
+		query.ActiveSince = query.ActiveSince.Add(day)
+	}
+
+	limit := query.Limit
+	query.Limit++ // one more for check next page
+
+	schedules, err := uc.repo.List(ctx, query)
+	if err != nil {
+		l.ErrorContext(ctx, "list schedules error", "err", err)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	list := &aggregate.ScheduleList{
+		Schedules: schedules,
+	}
+
+	if len(schedules) > limit {
+		list.Schedules = schedules[:limit]
+		if list.NextCursor, err = encodeListCursor(filter, list.Schedules[limit-1]); err != nil {
+			l.ErrorContext(ctx, "encode cursor error", "err", err)
+			return nil, fmt.Errorf("%s: %w", op, failure.NewInternalError(err.Error()))
+		}
+	}
+
+	uc.setScheduleEndHour(location, list.Schedules)
+
+	l.DebugContext(ctx, op, "schedules", len(list.Schedules), "next_cursor", list.NextCursor)
+
+	return list, nil
+}
+
+// GetOwner returns user of schedule for clients which address schedule only by id.
+func (uc *Usecase) GetOwner(ctx context.Context, scheduleId value.ScheduleId) (value.UserId, error) {
+	const op = "schedule.GetOwner"
+
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	l := contextx.GetLoggerOrDefault(ctx)
+
+	userId, err := uc.repo.GetOwner(ctx, scheduleId)
+	if err != nil {
+		l.ErrorContext(ctx, "get schedule owner error", "err", err, "scheduleId", scheduleId)
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return userId, nil
+}
+
 func (uc *Usecase) GetTimetable(ctx context.Context, userId value.UserId, scheduleId value.ScheduleId) (*aggregate.ScheduleWithTimetable, error) {
 	const op = "schedule.GetTimetable"
 
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	timetable, err := uc.getTimetable(ctx, userId, scheduleId, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return timetable, nil
+}
+
+// ExplainTimetable makes timetable same as GetTimetable and explains why each candidate slot is kept or rejected.
+func (uc *Usecase) ExplainTimetable(ctx context.Context, userId value.UserId, scheduleId value.ScheduleId) (*aggregate.ScheduleWithTimetable, []aggregate.ScheduleSlotDecision, error) {
+	const op = "schedule.ExplainTimetable"
+
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	explain := new(explanation)
+
+	timetable, err := uc.getTimetable(ctx, userId, scheduleId, explain)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return timetable, explain.getDecisions(), nil
+}
+
+func (uc *Usecase) getTimetable(ctx context.Context, userId value.UserId, scheduleId value.ScheduleId, explain *explanation) (*aggregate.ScheduleWithTimetable, error) {
 	l := contextx.GetLoggerOrDefault(ctx)
 
 	schedule, err := uc.repo.GetById(ctx, userId, scheduleId)
 	if err != nil {
 		l.ErrorContext(ctx, "get schedule error", "err", err, "scheduleId", scheduleId)
-		return nil, fmt.Errorf("%s: %w", op, err)
+		return nil, err
 	}
 
 	location := contextx.GetLocationOrDefault(ctx)
@@ -101,59 +316,702 @@ func (uc *Usecase) GetTimetable(ctx context.Context, userId value.UserId, schedu
 	uc.setScheduleEndHour(location, []*entity.Schedule{schedule})
 
 	timetable := &aggregate.ScheduleWithTimetable{
-		Id:        schedule.Id,
-		Name:      schedule.Name,
-		Period:    schedule.Period,
-		EndAt:     schedule.EndAt,
-		Timetable: []value.ScheduleTimeTableItem{},
+		Id:                     schedule.Id,
+		Name:                   schedule.Name,
+		Period:                 schedule.Period,
+		EndAt:                  schedule.EndAt,
+		RoundTheClock:          schedule.RoundTheClock,
+		AnchorAt:               schedule.AnchorAt,
+		Reanchor:               schedule.Reanchor,
+		TimesPerDay:            len(schedule.DaySlots),
+		DaySlots:               schedule.DaySlots,
+		Warnings:               getScheduleWarnings(schedule),
+		FinalDoseAt:            schedule.FinalDoseAt,
+		ConsolidationTolerance: schedule.ConsolidationTolerance,
+		Timetable:              []value.ScheduleTimeTableItem{},
 	}
 
-	now := time.Now().In(location)
-	l.DebugContext(ctx, op, "user time", now)
+	now := uc.Now(ctx)
+	l.DebugContext(ctx, "get timetable", "user time", now)
 
+	if schedule.FinalDoseAt != nil {
+		timetable.RemainingDoses = util.Ptr(uc.countRemainingDoses(ctx, schedule, now))
+	}
+
+	expiredBefore := now
 	if now.Round(time.Hour).Hour() > uc.cfg.EndDayHour { // if night then calculate for next day
 		l.DebugContext(ctx, "calculate for next day")
-		now = now.Add(day)
+		expiredBefore = now.Add(day)
 	}
 
-	if !schedule.EndAt.IsNil() && schedule.EndAt.Before(now) {
+	if !schedule.EndAt.IsNil() && schedule.EndAt.Before(expiredBefore) {
 		l.DebugContext(ctx, "schedule are expired", "schedule", schedule)
+		if explain != nil {
+			for _, item := range makeTimetable(ctx, schedule, now, uc.cfg.BeginDayHour, uc.cfg.EndDayHour, uc.cfg.TimeRound, nil) {
+				explain.reject(schedule, item.Time, aggregate.ScheduleSlotReasonExpired)
+			}
+		}
 		return timetable, nil
 	}
 
-	timetable.Timetable = makeTimetable(ctx, schedule, uc.cfg.BeginDayHour, uc.cfg.EndDayHour, uc.cfg.TimeRound)
+	timetable.Timetable = makeTimetable(ctx, schedule, now, uc.cfg.BeginDayHour, uc.cfg.EndDayHour, uc.cfg.TimeRound, explain)
+	uc.metrics.TimetableComputed(len(timetable.Timetable))
 
-	l.DebugContext(ctx, op, "timetable", timetable)
+	l.DebugContext(ctx, "get timetable", "timetable", timetable)
 
 	return timetable, nil
 }
 
+func (uc *Usecase) Update(ctx context.Context, userId value.UserId, scheduleId value.ScheduleId, update *aggregate.ScheduleUpdate) (*aggregate.ScheduleWithTimetable, error) {
+	const op = "schedule.Update"
+
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	l := contextx.GetLoggerOrDefault(ctx)
+
+	now := uc.Now(ctx)
+
+	if err := update.Validate(now); err != nil {
+		return nil, err
+	}
+
+	schedule, err := uc.repo.GetById(ctx, userId, scheduleId)
+	if err != nil {
+		l.ErrorContext(ctx, "get schedule error", "err", err, "scheduleId", scheduleId)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// course limited by number of doses keeps remaining doses when its takings change
+	var doses int
+	if schedule.FinalDoseAt != nil {
+		doses = uc.countRemainingDoses(ctx, schedule, now)
+	}
+
+	if update.Name != nil {
+		schedule.Name = *update.Name
+	}
+	if update.Period != nil {
+		schedule.Period = *update.Period
+		schedule.DaySlots = nil
+	}
+	if update.TimesPerDay != nil {
+		schedule.DaySlots, schedule.Period = newScheduleDaySlots(*update.TimesPerDay, uc.cfg.BeginDayHour, uc.cfg.EndDayHour, uc.cfg.TimeRound)
+	}
+	if update.Duration != nil {
+		schedule.EndAt = newScheduleEndAt(*update.Duration, now)
+		schedule.FinalDoseAt, doses = nil, 0
+	}
+	if update.Doses != nil {
+		doses = *update.Doses
+		if doses == 0 {
+			schedule.EndAt, schedule.FinalDoseAt = value.NewScheduleEndAt(nil), nil
+		}
+	}
+	if update.RoundTheClock != nil {
+		schedule.RoundTheClock = *update.RoundTheClock
+		if schedule.RoundTheClock && schedule.AnchorAt == nil {
+			schedule.AnchorAt = newScheduleAnchorAt(now, uc.cfg.BeginDayHour)
+		}
+	}
+	if update.AnchorAt != nil {
+		schedule.AnchorAt = util.Ptr(update.AnchorAt.UTC())
+	}
+	if update.Reanchor != nil {
+		schedule.Reanchor = *update.Reanchor
+	}
+	if update.ConsolidationTolerance != nil {
+		schedule.ConsolidationTolerance = newScheduleConsolidationTolerance(*update.ConsolidationTolerance)
+	}
+
+	if schedule.RoundTheClock && len(schedule.DaySlots) > 0 {
+		return nil, failure.NewValidationError(failure.Violation{Field: "round_the_clock", Description: "round-the-clock schedule must have period"})
+	}
+
+	if doses > 0 {
+		if err := uc.limitDoses(ctx, schedule, now, doses); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := uc.repo.Update(ctx, schedule); err != nil {
+		l.ErrorContext(ctx, "update schedule error", "err", err, "scheduleId", scheduleId)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	l.DebugContext(ctx, "update schedule", "schedule", schedule)
+
+	return uc.GetTimetable(ctx, userId, scheduleId)
+}
+
+// RecordIntake records intake of schedule at takenAt, zero takenAt means now, intake is kept for export.
+// First intake anchors schedule without anchor. Later intake moves anchor only if schedule is re-anchored
+// and intake is later than nearest taking by more than LateDoseTolerance.
+func (uc *Usecase) RecordIntake(ctx context.Context, userId value.UserId, scheduleId value.ScheduleId, takenAt time.Time) (*aggregate.ScheduleWithTimetable, error) {
+	const op = "schedule.RecordIntake"
+
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	l := contextx.GetLoggerOrDefault(ctx)
+
+	now := uc.Now(ctx)
+	if takenAt.IsZero() {
+		takenAt = now
+	}
+	if takenAt.After(now) {
+		return nil, failure.NewValidationError(failure.Violation{Field: "taken_at", Description: "taken at must not be in future"})
+	}
+	if now.Sub(takenAt) > entity.MaxAnchorShift { // intake may become anchor
+		return nil, failure.NewValidationError(failure.Violation{Field: "taken_at", Description: "taken at is too far in past"})
+	}
+	takenAt = takenAt.In(now.Location())
+
+	schedule, err := uc.repo.GetById(ctx, userId, scheduleId)
+	if err != nil {
+		l.ErrorContext(ctx, "get schedule error", "err", err, "scheduleId", scheduleId)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	intake := &entity.Intake{
+		ScheduleId: schedule.Id,
+		UserId:     schedule.UserId,
+		Name:       schedule.Name,
+		TakenAt:    takenAt.UTC(),
+	}
+	if err := uc.repo.SaveIntake(ctx, intake); err != nil {
+		l.ErrorContext(ctx, "save intake error", "err", err, "scheduleId", scheduleId)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	switch {
+	case schedule.AnchorAt == nil:
+		l.DebugContext(ctx, "first intake anchors schedule", "takenAt", takenAt)
+	case schedule.Reanchor && uc.isLateIntake(ctx, schedule, takenAt):
+		l.DebugContext(ctx, "late intake moves anchor", "takenAt", takenAt, "anchorAt", schedule.AnchorAt)
+	default:
+		l.DebugContext(ctx, "intake keeps anchor", "takenAt", takenAt, "anchorAt", schedule.AnchorAt)
+		return uc.GetTimetable(ctx, userId, scheduleId)
+	}
+
+	var doses int
+	if schedule.FinalDoseAt != nil {
+		doses = uc.countRemainingDoses(ctx, schedule, now)
+	}
+
+	schedule.AnchorAt = util.Ptr(takenAt.UTC())
+
+	if doses > 0 { // remaining doses are moved with anchor
+		if err := uc.limitDoses(ctx, schedule, now, doses); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := uc.repo.Update(ctx, schedule); err != nil {
+		l.ErrorContext(ctx, "update schedule error", "err", err, "scheduleId", scheduleId)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return uc.GetTimetable(ctx, userId, scheduleId)
+}
+
+// limitDoses ends course of schedule with doses-th taking after now, now is in user location.
+func (uc *Usecase) limitDoses(ctx context.Context, schedule *entity.Schedule, now time.Time, doses int) error {
+	schedule.FinalDoseAt = findFinalDose(ctx, schedule, now, doses, uc.cfg.BeginDayHour, uc.cfg.EndDayHour, uc.cfg.TimeRound)
+	if schedule.FinalDoseAt == nil {
+		return failure.NewValidationError(failure.Violation{Field: "doses", Description: "schedule has not enough takings for doses"})
+	}
+	schedule.EndAt = value.NewScheduleEndAt(schedule.FinalDoseAt)
+
+	contextx.GetLoggerOrDefault(ctx).DebugContext(ctx, "limit doses", "doses", doses, "finalDoseAt", schedule.FinalDoseAt)
+
+	return nil
+}
+
+func (uc *Usecase) countRemainingDoses(ctx context.Context, schedule *entity.Schedule, now time.Time) int {
+	return countRemainingDoses(ctx, schedule, now, uc.cfg.BeginDayHour, uc.cfg.EndDayHour, uc.cfg.TimeRound)
+}
+
+// isLateIntake checks that intake is later than nearest taking of its day by more than LateDoseTolerance, takenAt is in user location.
+func (uc *Usecase) isLateIntake(ctx context.Context, schedule *entity.Schedule, takenAt time.Time) bool {
+	var (
+		nearest time.Time
+		found   bool
+	)
+	for _, item := range makeTimetable(ctx, schedule, takenAt, uc.cfg.BeginDayHour, uc.cfg.EndDayHour, uc.cfg.TimeRound, nil) {
+		if !found || absDuration(item.Sub(takenAt)) < absDuration(nearest.Sub(takenAt)) {
+			nearest, found = item.Time, true
+		}
+	}
+	return found && takenAt.Sub(nearest) > uc.cfg.LateDoseTolerance
+}
+
+// GetIntakes returns recorded intakes of user ordered by taken at, taken at is in user location.
+func (uc *Usecase) GetIntakes(ctx context.Context, userId value.UserId) ([]*entity.Intake, error) {
+	const op = "schedule.GetIntakes"
+
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	l := contextx.GetLoggerOrDefault(ctx)
+
+	intakes, err := uc.repo.GetIntakes(ctx, userId)
+	if err != nil {
+		l.ErrorContext(ctx, "get intakes error", "err", err)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	loc := uc.Now(ctx).Location()
+	for _, intake := range intakes {
+		intake.TakenAt = intake.TakenAt.In(loc)
+	}
+
+	return intakes, nil
+}
+
+func (uc *Usecase) Delete(ctx context.Context, userId value.UserId, scheduleId value.ScheduleId) error {
+	const op = "schedule.Delete"
+
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	l := contextx.GetLoggerOrDefault(ctx)
+
+	if err := uc.repo.Delete(ctx, userId, scheduleId); err != nil {
+		l.ErrorContext(ctx, "delete schedule error", "err", err, "scheduleId", scheduleId)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	l.DebugContext(ctx, "delete schedule", "scheduleId", scheduleId)
+
+	return nil
+}
+
+func (uc *Usecase) GetHistory(ctx context.Context, userId value.UserId, scheduleId value.ScheduleId) ([]*entity.AuditRecord, error) {
+	const op = "schedule.GetHistory"
+
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	l := contextx.GetLoggerOrDefault(ctx)
+
+	records, err := uc.repo.GetHistory(ctx, userId, scheduleId)
+	if err != nil {
+		l.ErrorContext(ctx, "get schedule history error", "err", err, "scheduleId", scheduleId)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	l.DebugContext(ctx, op, "records", len(records))
+
+	return records, nil
+}
+
 func (uc *Usecase) GetNextTakings(ctx context.Context, userId value.UserId) ([]aggregate.ScheduleNextTaking, error) {
 	const op = "schedule.GetNextTakings"
 
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	nextTakings, err := uc.getNextTakings(ctx, userId, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nextTakings, nil
+}
+
+// ExplainNextTakings finds next takings same as GetNextTakings and explains why each candidate slot is kept or rejected.
+func (uc *Usecase) ExplainNextTakings(ctx context.Context, userId value.UserId) ([]aggregate.ScheduleNextTaking, []aggregate.ScheduleSlotDecision, error) {
+	const op = "schedule.ExplainNextTakings"
+
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	explain := new(explanation)
+
+	nextTakings, err := uc.getNextTakings(ctx, userId, explain)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nextTakings, explain.getDecisions(), nil
+}
+
+func (uc *Usecase) getNextTakings(ctx context.Context, userId value.UserId, explain *explanation) ([]aggregate.ScheduleNextTaking, error) {
 	l := contextx.GetLoggerOrDefault(ctx)
 
 	schedules, err := uc.repo.GetByUser(ctx, userId)
 	if err != nil {
 		l.ErrorContext(ctx, "get schedule by user error", "err", err)
-		return nil, fmt.Errorf("%s: %w", op, err)
+		return nil, err
 	}
 
 	location := contextx.GetLocationOrDefault(ctx)
-	now := time.Now().In(location)
-	l.DebugContext(ctx, op, "user time", now)
+	now := uc.Now(ctx)
+	l.DebugContext(ctx, "get next takings", "user time", now)
 
 	uc.setScheduleEndHour(location, schedules)
 
-	nextTakings := findNextTakings(ctx, schedules, uc.cfg.NextTakingPeriod, uc.cfg.BeginDayHour, uc.cfg.EndDayHour, uc.cfg.TimeRound)
+	nextTakings := findNextTakings(ctx, schedules, now, uc.cfg.NextTakingPeriod, uc.cfg.BeginDayHour, uc.cfg.EndDayHour, uc.cfg.TimeRound, explain)
+	uc.metrics.NextTakingsComputed()
+
+	settings, err := uc.repo.GetSettings(ctx, userId)
+	if err != nil {
+		l.ErrorContext(ctx, "get settings error", "err", err)
+		return nil, err
+	}
+	if settings.ConsolidateReminders {
+		nextTakings = uc.consolidateNextTakings(ctx, schedules, nextTakings, now)
+	}
 
-	l.DebugContext(ctx, op, "NextTakings", nextTakings)
+	l.DebugContext(ctx, "get next takings", "NextTakings", nextTakings)
 
 	return nextTakings, nil
 }
 
+// GetDayPlan merges timetables of schedules active at date, only year, month and day of date are used.
+// Zero date means today. Doses are consolidated if user enabled it in settings.
+func (uc *Usecase) GetDayPlan(ctx context.Context, userId value.UserId, date time.Time) (*aggregate.DayPlan, error) {
+	const op = "schedule.GetDayPlan"
+
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	l := contextx.GetLoggerOrDefault(ctx)
+
+	settings, err := uc.repo.GetSettings(ctx, userId)
+	if err != nil {
+		l.ErrorContext(ctx, "get settings error", "err", err)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	plan, err := uc.getDayPlan(ctx, userId, date, settings.ConsolidateReminders)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return plan, nil
+}
+
+// PreviewConsolidatedDayPlan makes day plan same as GetDayPlan with consolidated doses regardless of user settings.
+func (uc *Usecase) PreviewConsolidatedDayPlan(ctx context.Context, userId value.UserId, date time.Time) (*aggregate.DayPlan, error) {
+	const op = "schedule.PreviewConsolidatedDayPlan"
+
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	plan, err := uc.getDayPlan(ctx, userId, date, true)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return plan, nil
+}
+
+func (uc *Usecase) getDayPlan(ctx context.Context, userId value.UserId, date time.Time, consolidated bool) (*aggregate.DayPlan, error) {
+	l := contextx.GetLoggerOrDefault(ctx)
+
+	schedules, err := uc.repo.GetByUser(ctx, userId)
+	if err != nil {
+		l.ErrorContext(ctx, "get schedule by user error", "err", err)
+		return nil, err
+	}
+
+	location := contextx.GetLocationOrDefault(ctx)
+	if date.IsZero() {
+		date = uc.Now(ctx)
+	}
+	date = time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, location)
+	l.DebugContext(ctx, "get day plan", "date", date, "consolidated", consolidated)
+
+	uc.setScheduleEndHour(location, schedules)
+
+	plan := uc.makeDayPlan(ctx, schedules, date, consolidated)
+
+	intakes, err := uc.repo.GetIntakesBetween(ctx, userId, date, date.AddDate(0, 0, 1))
+	if err != nil {
+		l.ErrorContext(ctx, "get intakes error", "err", err)
+		return nil, err
+	}
+	uc.markTakenDoses(plan, intakes)
+
+	l.DebugContext(ctx, "get day plan", "slots", len(plan.Slots), "intakes", len(intakes))
+
+	return plan, nil
+}
+
+// makeDayPlan merges timetables of schedules active at date, date is begin of day in user location.
+func (uc *Usecase) makeDayPlan(ctx context.Context, schedules []*entity.Schedule, date time.Time, consolidated bool) *aggregate.DayPlan {
+	l := contextx.GetLoggerOrDefault(ctx)
+
+	plan := &aggregate.DayPlan{
+		Date:         date,
+		Slots:        make([]aggregate.DayPlanSlot, 0),
+		Consolidated: consolidated,
+	}
+
+	beginOfDay := time.Date(date.Year(), date.Month(), date.Day(), uc.cfg.BeginDayHour, 0, 0, 0, date.Location())
+	endOfDay := time.Date(date.Year(), date.Month(), date.Day(), uc.cfg.EndDayHour, 0, 0, 0, date.Location())
+
+	var doses []*consolidationDose
+	for _, schedule := range schedules {
+		if !schedule.EndAt.IsNil() && schedule.EndAt.Before(date) {
+			l.DebugContext(ctx, "schedule expired", "schedule", schedule)
+			continue
+		}
+
+		dose := aggregate.DayPlanDose{
+			ScheduleId: schedule.Id,
+			Name:       schedule.Name,
+		}
+		timetable := makeTimetable(ctx, schedule, date, uc.cfg.BeginDayHour, uc.cfg.EndDayHour, uc.cfg.TimeRound, nil)
+		doses = append(doses, newConsolidationDoses(dose, timetable, uc.consolidationTolerance(schedule), beginOfDay, endOfDay)...)
+	}
+
+	if consolidated {
+		consolidate(doses, uc.cfg.TimeRound)
+	}
+
+	for _, dose := range doses {
+		i, found := slices.BinarySearchFunc(plan.Slots, dose.at(), func(slot aggregate.DayPlanSlot, t time.Time) int {
+			return slot.Time.Compare(t)
+		})
+		if !found {
+			plan.Slots = slices.Insert(plan.Slots, i, aggregate.DayPlanSlot{Time: value.ScheduleTimeTableItem{Time: dose.at(), Night: dose.dose.PrescribedTime.Night}})
+		}
+		plan.Slots[i].Doses = append(plan.Slots[i].Doses, dose.dose)
+	}
+
+	return plan
+}
+
+// markTakenDoses matches each intake to nearest not taken dose of its schedule by prescribed time, same as isLateIntake.
+// Intakes without dose of their schedule in plan are skipped.
+func (uc *Usecase) markTakenDoses(plan *aggregate.DayPlan, intakes []*entity.Intake) {
+	for _, intake := range intakes {
+		takenAt := intake.TakenAt.In(plan.Date.Location())
+
+		var nearest *aggregate.DayPlanDose
+		for i := range plan.Slots {
+			for j := range plan.Slots[i].Doses {
+				dose := &plan.Slots[i].Doses[j]
+				if dose.ScheduleId != intake.ScheduleId || dose.TakenAt != nil {
+					continue
+				}
+				if nearest == nil || absDuration(dose.PrescribedTime.Sub(takenAt)) < absDuration(nearest.PrescribedTime.Sub(takenAt)) {
+					nearest = dose
+				}
+			}
+		}
+		if nearest == nil {
+			continue
+		}
+
+		nearest.TakenAt = &takenAt
+		nearest.Late = takenAt.Sub(nearest.PrescribedTime.Time) > uc.cfg.LateDoseTolerance
+	}
+}
+
+// consolidateNextTakings moves next takings to slots of consolidated day plans, takings are found in plans by prescribed time.
+// Taking stays at prescribed time if its slot is not after now, next taking must not be in the past.
+func (uc *Usecase) consolidateNextTakings(ctx context.Context, schedules []*entity.Schedule, nextTakings []aggregate.ScheduleNextTaking, now time.Time) []aggregate.ScheduleNextTaking {
+	plans := make(map[string]*aggregate.DayPlan)
+
+	moved := make([]aggregate.ScheduleNextTaking, 0, len(nextTakings))
+	for _, nextTaking := range nextTakings {
+		t := nextTaking.NextTaking.Time
+		date := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+
+		plan, ok := plans[date.Format(time.DateOnly)]
+		if !ok {
+			plan = uc.makeDayPlan(ctx, schedules, date, true)
+			plans[date.Format(time.DateOnly)] = plan
+		}
+
+	SlotsLoop:
+		for _, slot := range plan.Slots {
+			for _, dose := range slot.Doses {
+				if dose.ScheduleId == nextTaking.Id && dose.PrescribedTime.Equal(t) {
+					if slot.Time.After(now) {
+						nextTaking.NextTaking = value.NewScheduleNextTaking(slot.Time.Time)
+					}
+					break SlotsLoop
+				}
+			}
+		}
+
+		moved = util.InsertFunc(moved, nextTaking, func(v aggregate.ScheduleNextTaking) bool {
+			return nextTaking.NextTaking.Before(v.NextTaking.Time)
+		})
+	}
+
+	return moved
+}
+
+func (uc *Usecase) GetSettings(ctx context.Context, userId value.UserId) (*entity.UserSettings, error) {
+	const op = "schedule.GetSettings"
+
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	l := contextx.GetLoggerOrDefault(ctx)
+
+	settings, err := uc.repo.GetSettings(ctx, userId)
+	if err != nil {
+		l.ErrorContext(ctx, "get settings error", "err", err)
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return settings, nil
+}
+
+func (uc *Usecase) UpdateSettings(ctx context.Context, settings *entity.UserSettings) error {
+	const op = "schedule.UpdateSettings"
+
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	l := contextx.GetLoggerOrDefault(ctx)
+
+	if err := uc.repo.SaveSettings(ctx, settings); err != nil {
+		l.ErrorContext(ctx, "save settings error", "err", err)
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	l.DebugContext(ctx, "update settings", "settings", settings)
+
+	return nil
+}
+
+// Preview computes timetable for days since today and next takings of schedule without saving it.
+func (uc *Usecase) Preview(ctx context.Context, dto *aggregate.ScheduleWithDuration, days int) (*aggregate.SchedulePreview, error) {
+	const op = "schedule.Preview"
+
+	ctx, span := tracer.Start(ctx, op)
+	defer span.End()
+
+	l := contextx.GetLoggerOrDefault(ctx)
+
+	now := uc.Now(ctx)
+	l.DebugContext(ctx, op, "user time", now)
+
+	if err := dto.Validate(now); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case days < 0:
+		return nil, failure.NewValidationError(failure.Violation{Field: "days", Description: "days must not be negative"})
+	case days == 0:
+		days = aggregate.DefaultSchedulePreviewDays
+	case days > aggregate.MaxSchedulePreviewDays:
+		days = aggregate.MaxSchedulePreviewDays
+	}
+
+	location := contextx.GetLocationOrDefault(ctx)
+
+	schedule := newSchedule(dto, now, uc.cfg)
+	if dto.Doses > 0 {
+		if err := uc.limitDoses(ctx, schedule, now, dto.Doses); err != nil {
+			return nil, err
+		}
+	}
+	uc.setScheduleEndHour(location, []*entity.Schedule{schedule}) // same as after reading from db
+
+	preview := &aggregate.SchedulePreview{
+		Name:          schedule.Name,
+		EndAt:         schedule.EndAt,
+		Period:        schedule.Period,
+		RoundTheClock: schedule.RoundTheClock,
+		TimesPerDay:   len(schedule.DaySlots),
+		Warnings:      getScheduleWarnings(schedule),
+		FinalDoseAt:   schedule.FinalDoseAt,
+		Days:          make([]aggregate.SchedulePreviewDay, 0, days),
+		NextTakings:   make([]value.ScheduleNextTaking, 0),
+	}
+
+	for i := range days {
+		date := time.Date(now.Year(), now.Month(), now.Day()+i, 0, 0, 0, 0, location)
+		if !schedule.EndAt.IsNil() && schedule.EndAt.Before(date) {
+			l.DebugContext(ctx, "schedule ends before day", "day", date)
+			break
+		}
+
+		preview.Days = append(preview.Days, aggregate.SchedulePreviewDay{
+			Date:      date,
+			Timetable: makeTimetable(ctx, schedule, date, uc.cfg.BeginDayHour, uc.cfg.EndDayHour, uc.cfg.TimeRound, nil),
+		})
+	}
+
+	for _, nextTaking := range findNextTakings(ctx, []*entity.Schedule{schedule}, now, uc.cfg.NextTakingPeriod, uc.cfg.BeginDayHour, uc.cfg.EndDayHour, uc.cfg.TimeRound, nil) {
+		preview.NextTakings = append(preview.NextTakings, nextTaking.NextTaking)
+	}
+
+	if schedule.FinalDoseAt != nil {
+		preview.RemainingDoses = util.Ptr(dto.Doses)
+	}
+
+	l.DebugContext(ctx, op, "preview", preview)
+
+	return preview, nil
+}
+
+func newSchedule(dto *aggregate.ScheduleWithDuration, now time.Time, cfg config.ScheduleConfig) *entity.Schedule {
+	schedule := &entity.Schedule{
+		UserId:                 dto.UserId,
+		Name:                   dto.Name,
+		EndAt:                  newScheduleEndAt(dto.Duration, now),
+		Period:                 dto.Period,
+		RoundTheClock:          dto.RoundTheClock,
+		Reanchor:               dto.Reanchor,
+		ConsolidationTolerance: newScheduleConsolidationTolerance(dto.ConsolidationTolerance),
+	}
+	switch {
+	case len(dto.DaySlots) > 0:
+		schedule.DaySlots, schedule.Period = newScheduleFixedDaySlots(dto.DaySlots)
+	case dto.TimesPerDay > 0:
+		schedule.DaySlots, schedule.Period = newScheduleDaySlots(dto.TimesPerDay, cfg.BeginDayHour, cfg.EndDayHour, cfg.TimeRound)
+	}
+	switch {
+	case dto.AnchorAt != nil:
+		schedule.AnchorAt = util.Ptr(dto.AnchorAt.UTC())
+	case schedule.RoundTheClock:
+		schedule.AnchorAt = newScheduleAnchorAt(now, cfg.BeginDayHour)
+	}
+	return schedule
+}
+
+// newScheduleConsolidationTolerance returns nil for zero tolerance, so default of config is used.
+func newScheduleConsolidationTolerance(tolerance time.Duration) *time.Duration {
+	if tolerance == 0 {
+		return nil
+	}
+	return &tolerance
+}
+
+// newScheduleAnchorAt is begin of day of now, round-the-clock takings are counted from it.
+func newScheduleAnchorAt(now time.Time, beginDayHour int) *time.Time {
+	return util.Ptr(time.Date(now.Year(), now.Month(), now.Day(), beginDayHour, 0, 0, 0, now.Location()).UTC())
+}
+
+func newScheduleEndAt(duration value.ScheduleDuration, now time.Time) value.ScheduleEndAt {
+	var expiredAt *time.Time
+	if duration > 0 {
+		expiredAt = util.Ptr(now.Add(time.Duration(duration) * day))
+	}
+	return value.NewScheduleEndAt(expiredAt)
+}
+
+// setScheduleEndHour sets end of schedule to end of its last day, course limited by number of doses
+// ends not before its final dose, so night final dose of round-the-clock schedule is not expired.
 func (uc *Usecase) setScheduleEndHour(loc *time.Location, schedules []*entity.Schedule) { // in db this is DATE type without time
 	for _, s := range schedules {
+		if s.FinalDoseAt != nil {
+			finalDoseAt := s.FinalDoseAt.In(loc)
+			endAt := time.Date(finalDoseAt.Year(), finalDoseAt.Month(), finalDoseAt.Day(), uc.cfg.EndDayHour, 0, 0, 0, loc)
+			s.EndAt = value.NewScheduleEndAt(util.Ptr(latest(endAt, finalDoseAt)))
+			continue
+		}
 		if !s.EndAt.IsNil() {
 			s.EndAt = value.NewScheduleEndAt(util.Ptr(time.Date(s.EndAt.Year(), s.EndAt.Month(), s.EndAt.Day(), uc.cfg.EndDayHour, 0, 0, 0, loc)))
 		}
@@ -2,20 +2,23 @@ package schedule
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
 	"schedule/internal/domain/aggregate"
 	"schedule/internal/domain/entity"
 	"schedule/internal/domain/value"
 	"schedule/internal/util"
 	"schedule/pkg/contextx"
+	"slices"
 	"time"
 )
 
-func getActualSchedulesIds(ctx context.Context, schedules []*entity.Schedule) []value.ScheduleId {
+func getActualSchedulesIds(ctx context.Context, schedules []*entity.Schedule, now time.Time) []value.ScheduleId {
 	l := contextx.GetLoggerOrDefault(ctx)
 
-	location := contextx.GetLocationOrDefault(ctx)
-	now := time.Now().In(location)
-
 	var ids []value.ScheduleId
 	for _, schedule := range schedules {
 		if schedule.EndAt.IsNil() || schedule.EndAt.After(now) {
@@ -29,37 +32,296 @@ func getActualSchedulesIds(ctx context.Context, schedules []*entity.Schedule) []
 	return ids
 }
 
-func makeTimetable(ctx context.Context, schedule *entity.Schedule, beginDayHour, endDayHour int, round time.Duration) value.ScheduleTimeTable {
+// makeTimetable makes timetable of day of now, now is in user location.
+// Decisions about candidate slots are added to explain if it is not nil.
+func makeTimetable(ctx context.Context, schedule *entity.Schedule, now time.Time, beginDayHour, endDayHour int, round time.Duration, explain *explanation) value.ScheduleTimeTable {
+	if len(schedule.DaySlots) > 0 {
+		return makeDaySlotsTimetable(ctx, schedule, now, explain)
+	}
+	if schedule.RoundTheClock {
+		return makeRoundTheClockTimetable(ctx, schedule, now, beginDayHour, endDayHour, round, explain)
+	}
+
 	l := contextx.GetLoggerOrDefault(ctx)
 
-	location := contextx.GetLocationOrDefault(ctx)
-	now := time.Now().In(location)
+	location := now.Location()
 
 	timetable := value.ScheduleTimeTable{}
 
 	beginOfCurrentDay := time.Date(now.Year(), now.Month(), now.Day(), beginDayHour, 0, 0, 0, location)
 	endOfCurrentDay := time.Date(now.Year(), now.Month(), now.Day(), endDayHour, 0, 0, 0, location)
 
+	firstTaking := firstTakingOfDay(schedule, beginOfCurrentDay)
+
 	for i := 0; ; i++ {
-		timestamp := beginOfCurrentDay.Add(time.Duration(i) * time.Duration(schedule.Period))
-		timestamp = timestamp.Round(round)
+		taking := firstTaking.Add(time.Duration(i) * time.Duration(schedule.Period))
+		timestamp := taking.Round(round)
 
 		if endOfCurrentDay.Before(timestamp) {
 			l.DebugContext(ctx, "day end", "timestamp", timestamp)
+			explain.reject(schedule, timestamp, aggregate.ScheduleSlotReasonNight)
+			break
+		}
+
+		if isAfterFinalDose(schedule, timestamp) {
+			l.DebugContext(ctx, "after final dose", "timestamp", timestamp)
+			explain.reject(schedule, timestamp, aggregate.ScheduleSlotReasonExpired)
+			break
+		}
+
+		if isBeforeAnchor(schedule, taking) {
+			l.DebugContext(ctx, "before anchor", "timestamp", timestamp)
+			explain.reject(schedule, timestamp, aggregate.ScheduleSlotReasonBeforeAnchor)
+			continue
+		}
+
+		if len(timetable) > 0 && timetable[len(timetable)-1].Equal(timestamp) {
+			l.DebugContext(ctx, "rounded into previous slot", "timestamp", timestamp)
+			explain.reject(schedule, timestamp, aggregate.ScheduleSlotReasonRounded)
+			continue
+		}
+
+		timetable = append(timetable, value.NewScheduleTimeTableItem(timestamp))
+		explain.keep(schedule, timestamp)
+	}
+
+	return timetable
+}
+
+// makeDaySlotsTimetable makes timetable of day of now from fixed times of takings.
+// They are not rounded and not limited by day window, so they do not shift when config changes.
+func makeDaySlotsTimetable(ctx context.Context, schedule *entity.Schedule, now time.Time, explain *explanation) value.ScheduleTimeTable {
+	l := contextx.GetLoggerOrDefault(ctx)
+
+	timetable := value.ScheduleTimeTable{}
+
+	for _, slot := range schedule.DaySlots {
+		timestamp := daySlotTime(now, 0, slot)
+
+		if isAfterFinalDose(schedule, timestamp) {
+			l.DebugContext(ctx, "after final dose", "timestamp", timestamp)
+			explain.reject(schedule, timestamp, aggregate.ScheduleSlotReasonExpired)
 			break
 		}
 
+		if isBeforeAnchor(schedule, timestamp) {
+			l.DebugContext(ctx, "before anchor", "timestamp", timestamp)
+			explain.reject(schedule, timestamp, aggregate.ScheduleSlotReasonBeforeAnchor)
+			continue
+		}
+
 		timetable = append(timetable, value.NewScheduleTimeTableItem(timestamp))
+		explain.keep(schedule, timestamp)
 	}
 
 	return timetable
 }
 
-func findNextTakings(ctx context.Context, schedules []*entity.Schedule, period time.Duration, beginDayHour, endDayHour int, round time.Duration) []aggregate.ScheduleNextTaking {
+// newScheduleDaySlots spreads takings evenly over day window, first taking is at begin of day and last one is at end of day.
+// Period is interval between takings before rounding truncated to seconds, whole day for single taking.
+func newScheduleDaySlots(timesPerDay, beginDayHour, endDayHour int, round time.Duration) (value.ScheduleDaySlots, value.SchedulePeriod) {
+	begin := time.Duration(beginDayHour) * time.Hour
+	if timesPerDay == 1 {
+		return value.ScheduleDaySlots{begin}, entity.MaxSchedulePeriod
+	}
+
+	interval := time.Duration(endDayHour-beginDayHour) * time.Hour / time.Duration(timesPerDay-1)
+
+	slots := make(value.ScheduleDaySlots, 0, timesPerDay)
+	for i := range timesPerDay {
+		slot := (begin + time.Duration(i)*interval).Round(round)
+		if len(slots) > 0 && slots[len(slots)-1] == slot { // rounded into previous slot
+			continue
+		}
+		slots = append(slots, slot)
+	}
+
+	return slots, value.SchedulePeriod(interval.Truncate(time.Second))
+}
+
+// newScheduleFixedDaySlots sorts given times of takings, period is shortest interval between them
+// or max period for single taking, so warnings and consolidation see the tightest gap.
+func newScheduleFixedDaySlots(slots value.ScheduleDaySlots) (value.ScheduleDaySlots, value.SchedulePeriod) {
+	slots = slices.Compact(slices.Sorted(slices.Values(slots)))
+
+	period := time.Duration(entity.MaxSchedulePeriod)
+	for i := 1; i < len(slots); i++ {
+		period = min(period, slots[i]-slots[i-1])
+	}
+
+	return slots, value.SchedulePeriod(period)
+}
+
+// getScheduleWarnings checks fixed times of takings, takings stepped by period are checked by validation.
+// Interval is checked before rounding too, because rounding may merge takings.
+func getScheduleWarnings(schedule *entity.Schedule) []aggregate.ScheduleWarning {
+	if len(schedule.DaySlots) == 0 {
+		return nil
+	}
+	if schedule.Period < entity.MinSchedulePeriod {
+		return []aggregate.ScheduleWarning{aggregate.ScheduleWarningShortInterval}
+	}
+	for i := 1; i < len(schedule.DaySlots); i++ {
+		if schedule.DaySlots[i]-schedule.DaySlots[i-1] < time.Duration(entity.MinSchedulePeriod) {
+			return []aggregate.ScheduleWarning{aggregate.ScheduleWarningShortInterval}
+		}
+	}
+	return nil
+}
+
+// makeRoundTheClockTimetable makes timetable of whole day of now, takings are counted from anchor across midnight.
+// Takings outside of day window are kept and marked as night.
+func makeRoundTheClockTimetable(ctx context.Context, schedule *entity.Schedule, now time.Time, beginDayHour, endDayHour int, round time.Duration, explain *explanation) value.ScheduleTimeTable {
+	l := contextx.GetLoggerOrDefault(ctx)
+
+	location := now.Location()
+
+	timetable := value.ScheduleTimeTable{}
+
+	beginOfCurrentDay := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, location)
+	beginOfNextDay := time.Date(now.Year(), now.Month(), now.Day()+1, 0, 0, 0, 0, location)
+
+	anchor := roundTheClockAnchor(schedule, now, beginDayHour)
+
+	for i := firstTakingIndex(anchor, beginOfCurrentDay, schedule.Period); ; i++ {
+		timestamp := anchor.Add(time.Duration(i) * time.Duration(schedule.Period))
+		timestamp = timestamp.Round(round)
+
+		if timestamp.Before(beginOfCurrentDay) { // rounded into previous day
+			continue
+		}
+
+		if !timestamp.Before(beginOfNextDay) {
+			l.DebugContext(ctx, "day end", "timestamp", timestamp)
+			break
+		}
+
+		if isAfterFinalDose(schedule, timestamp) {
+			l.DebugContext(ctx, "after final dose", "timestamp", timestamp)
+			explain.reject(schedule, timestamp, aggregate.ScheduleSlotReasonExpired)
+			break
+		}
+
+		if len(timetable) > 0 && timetable[len(timetable)-1].Equal(timestamp) {
+			l.DebugContext(ctx, "rounded into previous slot", "timestamp", timestamp)
+			explain.reject(schedule, timestamp, aggregate.ScheduleSlotReasonRounded)
+			continue
+		}
+
+		timetable = append(timetable, value.ScheduleTimeTableItem{Time: timestamp, Night: isNight(timestamp, beginDayHour, endDayHour)})
+		explain.keep(schedule, timestamp)
+	}
+
+	return timetable
+}
+
+// roundTheClockAnchor is time of first taking of round-the-clock schedule in location of now.
+// Schedule without anchor is counted from begin of day of now. There are no takings before anchor.
+func roundTheClockAnchor(schedule *entity.Schedule, now time.Time, beginDayHour int) time.Time {
+	if schedule.AnchorAt != nil {
+		return schedule.AnchorAt.In(now.Location())
+	}
+	return time.Date(now.Year(), now.Month(), now.Day(), beginDayHour, 0, 0, 0, now.Location())
+}
+
+// firstTakingIndex is index of taking counted from anchor which is just before t, so rounded takings near t are not lost.
+func firstTakingIndex(anchor, t time.Time, period value.SchedulePeriod) int {
+	if !t.After(anchor) {
+		return 0
+	}
+	return int(t.Sub(anchor) / time.Duration(period))
+}
+
+// firstTakingOfDay is first taking of day not before beginOfDay.
+// Takings of anchored schedule are stepped from time of day of anchor, else from beginOfDay.
+func firstTakingOfDay(schedule *entity.Schedule, beginOfDay time.Time) time.Time {
+	if schedule.AnchorAt == nil {
+		return beginOfDay
+	}
+
+	anchor := schedule.AnchorAt.In(beginOfDay.Location())
+	anchorOfDay := time.Date(beginOfDay.Year(), beginOfDay.Month(), beginOfDay.Day(), anchor.Hour(), anchor.Minute(), anchor.Second(), anchor.Nanosecond(), beginOfDay.Location())
+
+	offset := anchorOfDay.Sub(beginOfDay) % time.Duration(schedule.Period)
+	if offset < 0 {
+		offset += time.Duration(schedule.Period)
+	}
+	return beginOfDay.Add(offset)
+}
+
+// isBeforeAnchor checks that taking is before anchor, so course is not started yet. Taking must not be rounded.
+func isBeforeAnchor(schedule *entity.Schedule, taking time.Time) bool {
+	return schedule.AnchorAt != nil && taking.Before(*schedule.AnchorAt)
+}
+
+// isAfterFinalDose checks that taking is after last taking of course limited by number of doses.
+func isAfterFinalDose(schedule *entity.Schedule, timestamp time.Time) bool {
+	return schedule.FinalDoseAt != nil && timestamp.After(*schedule.FinalDoseAt)
+}
+
+// isExpired checks that taking is after end of schedule or after its final dose.
+func isExpired(schedule *entity.Schedule, timestamp time.Time) bool {
+	return (!schedule.EndAt.IsNil() && timestamp.After(schedule.EndAt.ToTime())) || isAfterFinalDose(schedule, timestamp)
+}
+
+// findFinalDose finds doses-th taking of schedule after start, start is in user location.
+// Takings are taken from timetables of days, so night, days before anchor and takings rounded together are not counted.
+// Nil means there are not enough takings, e.g. anchor of schedule is outside of day window.
+func findFinalDose(ctx context.Context, schedule *entity.Schedule, start time.Time, doses int, beginDayHour, endDayHour int, round time.Duration) *time.Time {
+	unlimited := *schedule
+	unlimited.FinalDoseAt = nil
+
+	maxDays := doses + 1 // every day since anchor has taking
+	if schedule.AnchorAt != nil && schedule.AnchorAt.After(start) {
+		maxDays += int(schedule.AnchorAt.Sub(start)/day) + 1
+	}
+
+	for days := range maxDays {
+		date := time.Date(start.Year(), start.Month(), start.Day()+days, 0, 0, 0, 0, start.Location())
+		for _, item := range makeTimetable(ctx, &unlimited, date, beginDayHour, endDayHour, round, nil) {
+			if !item.After(start) {
+				continue
+			}
+			if doses--; doses == 0 {
+				return util.Ptr(item.UTC())
+			}
+		}
+	}
+
+	return nil
+}
+
+// countRemainingDoses counts takings of schedule after now up to final dose, now is in user location.
+func countRemainingDoses(ctx context.Context, schedule *entity.Schedule, now time.Time, beginDayHour, endDayHour int, round time.Duration) int {
+	finalDoseAt := schedule.FinalDoseAt.In(now.Location())
+
+	count := 0
+	for days := 0; ; days++ {
+		date := time.Date(now.Year(), now.Month(), now.Day()+days, 0, 0, 0, 0, now.Location())
+		if date.After(finalDoseAt) {
+			break
+		}
+		for _, item := range makeTimetable(ctx, schedule, date, beginDayHour, endDayHour, round, nil) {
+			if item.After(now) {
+				count++
+			}
+		}
+	}
+
+	return count
+}
+
+// isNight checks that t in user location is outside of day window.
+func isNight(t time.Time, beginDayHour, endDayHour int) bool {
+	return t.Hour() < beginDayHour || t.Hour() >= endDayHour
+}
+
+// findNextTakings finds takings in period after now, now is in user location.
+// Decisions about candidate slots are added to explain if it is not nil.
+func findNextTakings(ctx context.Context, schedules []*entity.Schedule, now time.Time, period time.Duration, beginDayHour, endDayHour int, round time.Duration, explain *explanation) []aggregate.ScheduleNextTaking {
 	l := contextx.GetLoggerOrDefault(ctx)
 
-	location := contextx.GetLocationOrDefault(ctx)
-	now := time.Now().In(location)
+	location := now.Location()
 
 	nextTakingPeriod := now.Add(period)
 
@@ -68,45 +330,66 @@ func findNextTakings(ctx context.Context, schedules []*entity.Schedule, period t
 	for _, schedule := range schedules {
 		l.DebugContext(ctx, "finding taking", "schedule", schedule)
 
+		if len(schedule.DaySlots) > 0 {
+			nextTakings = findDaySlotsTakings(ctx, schedule, now, nextTakingPeriod, nextTakings, explain)
+			continue
+		}
+
+		if schedule.RoundTheClock {
+			nextTakings = findRoundTheClockTakings(ctx, schedule, now, nextTakingPeriod, beginDayHour, endDayHour, round, nextTakings, explain)
+			continue
+		}
+
+		var prevTimestamp time.Time
+
 	DaysLoop:
 		for days := 0; ; days++ {
 			beginOfCurrentDay := time.Date(now.Year(), now.Month(), now.Day()+days, beginDayHour, 0, 0, 0, location)
 			l.DebugContext(ctx, "finding for day", "day", beginOfCurrentDay)
 
+			firstTaking := firstTakingOfDay(schedule, beginOfCurrentDay)
+
 			for i := 0; ; i++ {
-				timestamp := beginOfCurrentDay.Add(time.Duration(i) * time.Duration(schedule.Period))
-				timestamp = timestamp.Round(round)
+				taking := firstTaking.Add(time.Duration(i) * time.Duration(schedule.Period))
+				timestamp := taking.Round(round)
 				l.DebugContext(ctx, "checking timestamp", "timestamp", timestamp)
 
-				if !schedule.EndAt.IsNil() && timestamp.After(schedule.EndAt.ToTime()) { // if schedule end
+				if isExpired(schedule, timestamp) { // if schedule end
 					l.DebugContext(ctx, "schedule expired", "schedule", schedule, "timestamp", timestamp)
+					explain.reject(schedule, timestamp, aggregate.ScheduleSlotReasonExpired)
 					break DaysLoop
 				}
 
 				if timestamp.After(nextTakingPeriod) {
 					l.DebugContext(ctx, "schedule out of period", "schedule", schedule, "timestamp", timestamp)
+					explain.reject(schedule, timestamp, aggregate.ScheduleSlotReasonOutsidePeriod)
 					break DaysLoop
 				}
 
-				if timestamp.Hour() < beginDayHour || timestamp.Hour() >= endDayHour {
+				if isNight(timestamp, beginDayHour, endDayHour) {
 					l.DebugContext(ctx, "now night", "schedule", schedule, "timestamp", timestamp)
+					explain.reject(schedule, timestamp, aggregate.ScheduleSlotReasonNight)
 					break
 				}
 
+				if isBeforeAnchor(schedule, taking) {
+					l.DebugContext(ctx, "before anchor", "schedule", schedule, "timestamp", timestamp)
+					explain.reject(schedule, timestamp, aggregate.ScheduleSlotReasonBeforeAnchor)
+					continue
+				}
+
+				if timestamp.Equal(prevTimestamp) {
+					l.DebugContext(ctx, "rounded into previous slot", "schedule", schedule, "timestamp", timestamp)
+					explain.reject(schedule, timestamp, aggregate.ScheduleSlotReasonRounded)
+					continue
+				}
+				prevTimestamp = timestamp
+
 				if timestamp.After(now) {
-					nextTaking := aggregate.ScheduleNextTaking{
-						Id:         schedule.Id,
-						Name:       schedule.Name,
-						EndAt:      schedule.EndAt,
-						Period:     schedule.Period,
-						NextTaking: value.NewScheduleNextTaking(timestamp),
-					}
-
-					l.DebugContext(ctx, "find next taking", "nextTaking", nextTaking)
-
-					nextTakings = util.InsertFunc(nextTakings, nextTaking, func(v aggregate.ScheduleNextTaking) bool { // make sorted result
-						return nextTaking.NextTaking.Before(v.NextTaking.Time)
-					})
+					nextTakings = addNextTaking(ctx, nextTakings, schedule, timestamp, false)
+					explain.keep(schedule, timestamp)
+				} else {
+					explain.reject(schedule, timestamp, aggregate.ScheduleSlotReasonPassed)
 				}
 			}
 		}
@@ -115,3 +398,155 @@ func findNextTakings(ctx context.Context, schedules []*entity.Schedule, period t
 
 	return nextTakings
 }
+
+// daySlotTime is time of slot on day of now shifted by days, slot is wall clock time,
+// so it is not shifted on days of daylight saving change.
+func daySlotTime(now time.Time, days int, slot time.Duration) time.Time {
+	hour, minute, sec := int(slot/time.Hour), int(slot%time.Hour/time.Minute), int(slot%time.Minute/time.Second)
+	return time.Date(now.Year(), now.Month(), now.Day()+days, hour, minute, sec, 0, now.Location())
+}
+
+// findDaySlotsTakings adds takings of schedule with fixed times of takings in period after now.
+func findDaySlotsTakings(ctx context.Context, schedule *entity.Schedule, now, nextTakingPeriod time.Time, nextTakings []aggregate.ScheduleNextTaking, explain *explanation) []aggregate.ScheduleNextTaking {
+	l := contextx.GetLoggerOrDefault(ctx)
+
+	for days := 0; ; days++ {
+		l.DebugContext(ctx, "finding for day", "day", daySlotTime(now, days, 0))
+
+		for _, slot := range schedule.DaySlots {
+			timestamp := daySlotTime(now, days, slot)
+			l.DebugContext(ctx, "checking timestamp", "timestamp", timestamp)
+
+			if isExpired(schedule, timestamp) {
+				l.DebugContext(ctx, "schedule expired", "schedule", schedule, "timestamp", timestamp)
+				explain.reject(schedule, timestamp, aggregate.ScheduleSlotReasonExpired)
+				return nextTakings
+			}
+
+			if timestamp.After(nextTakingPeriod) {
+				l.DebugContext(ctx, "schedule out of period", "schedule", schedule, "timestamp", timestamp)
+				explain.reject(schedule, timestamp, aggregate.ScheduleSlotReasonOutsidePeriod)
+				return nextTakings
+			}
+
+			if isBeforeAnchor(schedule, timestamp) {
+				l.DebugContext(ctx, "before anchor", "schedule", schedule, "timestamp", timestamp)
+				explain.reject(schedule, timestamp, aggregate.ScheduleSlotReasonBeforeAnchor)
+				continue
+			}
+
+			if timestamp.After(now) {
+				nextTakings = addNextTaking(ctx, nextTakings, schedule, timestamp, false)
+				explain.keep(schedule, timestamp)
+			} else {
+				explain.reject(schedule, timestamp, aggregate.ScheduleSlotReasonPassed)
+			}
+		}
+	}
+}
+
+// findRoundTheClockTakings adds takings of round-the-clock schedule in period after now, takings are counted from anchor.
+func findRoundTheClockTakings(ctx context.Context, schedule *entity.Schedule, now, nextTakingPeriod time.Time, beginDayHour, endDayHour int, round time.Duration, nextTakings []aggregate.ScheduleNextTaking, explain *explanation) []aggregate.ScheduleNextTaking {
+	l := contextx.GetLoggerOrDefault(ctx)
+
+	anchor := roundTheClockAnchor(schedule, now, beginDayHour)
+
+	var prevTimestamp time.Time
+
+	for i := firstTakingIndex(anchor, now, schedule.Period); ; i++ {
+		timestamp := anchor.Add(time.Duration(i) * time.Duration(schedule.Period))
+		timestamp = timestamp.Round(round)
+		l.DebugContext(ctx, "checking timestamp", "timestamp", timestamp)
+
+		if isExpired(schedule, timestamp) {
+			l.DebugContext(ctx, "schedule expired", "schedule", schedule, "timestamp", timestamp)
+			explain.reject(schedule, timestamp, aggregate.ScheduleSlotReasonExpired)
+			break
+		}
+
+		if timestamp.After(nextTakingPeriod) {
+			l.DebugContext(ctx, "schedule out of period", "schedule", schedule, "timestamp", timestamp)
+			explain.reject(schedule, timestamp, aggregate.ScheduleSlotReasonOutsidePeriod)
+			break
+		}
+
+		if timestamp.Equal(prevTimestamp) {
+			l.DebugContext(ctx, "rounded into previous slot", "schedule", schedule, "timestamp", timestamp)
+			explain.reject(schedule, timestamp, aggregate.ScheduleSlotReasonRounded)
+			continue
+		}
+		prevTimestamp = timestamp
+
+		if timestamp.After(now) {
+			nextTakings = addNextTaking(ctx, nextTakings, schedule, timestamp, isNight(timestamp, beginDayHour, endDayHour))
+			explain.keep(schedule, timestamp)
+		} else {
+			explain.reject(schedule, timestamp, aggregate.ScheduleSlotReasonPassed)
+		}
+	}
+
+	return nextTakings
+}
+
+// addNextTaking inserts taking of schedule keeping next takings sorted by time.
+func addNextTaking(ctx context.Context, nextTakings []aggregate.ScheduleNextTaking, schedule *entity.Schedule, timestamp time.Time, night bool) []aggregate.ScheduleNextTaking {
+	nextTaking := aggregate.ScheduleNextTaking{
+		Id:         schedule.Id,
+		Name:       schedule.Name,
+		EndAt:      schedule.EndAt,
+		Period:     schedule.Period,
+		NextTaking: value.NewScheduleNextTaking(timestamp),
+		Night:      night,
+	}
+
+	contextx.GetLoggerOrDefault(ctx).DebugContext(ctx, "find next taking", "nextTaking", nextTaking)
+
+	return util.InsertFunc(nextTakings, nextTaking, func(v aggregate.ScheduleNextTaking) bool { // make sorted result
+		return nextTaking.NextTaking.Before(v.NextTaking.Time)
+	})
+}
+
+func encodeListCursor(filter *aggregate.ScheduleListFilter, last *entity.Schedule) (string, error) {
+	cursor := aggregate.ScheduleListCursor{
+		Id:     last.Id,
+		Name:   last.Name,
+		EndAt:  last.EndAt.Time,
+		Sort:   filter.Sort,
+		Desc:   filter.Desc,
+		Filter: hashListFilter(filter),
+	}
+
+	p, err := json.Marshal(cursor)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(p), nil
+}
+
+// hashListFilter hashes conditions of filter, sort, cursor and limit are not included.
+func hashListFilter(filter *aggregate.ScheduleListFilter) string {
+	formatDate := func(t *time.Time) string {
+		if t == nil {
+			return ""
+		}
+		return t.UTC().Format(time.RFC3339Nano)
+	}
+
+	sum := sha256.Sum256(fmt.Appendf(nil, "%d\x00%s\x00%s\x00%s\x00%s", filter.UserId, filter.NameContains, filter.Status, formatDate(filter.EndAtFrom), formatDate(filter.EndAtTo)))
+	return hex.EncodeToString(sum[:8])
+}
+
+func decodeListCursor(s string) (*aggregate.ScheduleListCursor, error) {
+	p, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+
+	cursor := new(aggregate.ScheduleListCursor)
+	if err := json.Unmarshal(p, cursor); err != nil {
+		return nil, err
+	}
+
+	return cursor, nil
+}
@@ -0,0 +1,73 @@
+package value
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// ScheduleDaySlots are times of takings as offsets from midnight in user location.
+// Stored as json array of times of day, nil is stored as null.
+type ScheduleDaySlots []time.Duration
+
+func (s ScheduleDaySlots) ToStringArray() []string {
+	arr := make([]string, len(s))
+	for i, slot := range s {
+		arr[i] = time.Time{}.Add(slot).Format(time.TimeOnly)
+	}
+	return arr
+}
+
+func (s ScheduleDaySlots) MarshalJSON() ([]byte, error) {
+	if s == nil {
+		return []byte("null"), nil
+	}
+	return json.Marshal(s.ToStringArray())
+}
+
+func (s *ScheduleDaySlots) UnmarshalJSON(data []byte) error {
+	var arr []string
+	if err := json.Unmarshal(data, &arr); err != nil {
+		return err
+	}
+	if arr == nil {
+		*s = nil
+		return nil
+	}
+
+	slots := make(ScheduleDaySlots, len(arr))
+	for i, str := range arr {
+		t, err := time.Parse(time.TimeOnly, str)
+		if err != nil {
+			return err
+		}
+		slots[i] = time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute + time.Duration(t.Second())*time.Second
+	}
+	*s = slots
+	return nil
+}
+
+func (s *ScheduleDaySlots) Scan(v any) error {
+	switch v := v.(type) {
+	case nil:
+		*s = nil
+		return nil
+	case []byte:
+		return json.Unmarshal(v, s)
+	case string:
+		return json.Unmarshal([]byte(v), s)
+	}
+	return fmt.Errorf("'%v' (type %T) is not a json", v, v)
+}
+
+func (s ScheduleDaySlots) Value() (driver.Value, error) {
+	if s == nil {
+		return nil, nil
+	}
+	p, err := json.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+	return string(p), nil
+}
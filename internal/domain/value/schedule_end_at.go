@@ -2,6 +2,7 @@ package value
 
 import (
 	"database/sql/driver"
+	"encoding/json"
 	"fmt"
 	"schedule/internal/util"
 	"time"
@@ -40,6 +41,25 @@ func (t ScheduleEndAt) MarshalJSON() ([]byte, error) {
 	return []byte("\"" + t.String() + "\""), nil
 }
 
+func (t *ScheduleEndAt) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		t.Time = nil
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	endAt, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return err
+	}
+	t.Time = &endAt
+	return nil
+}
+
 func (t ScheduleEndAt) IsNil() bool {
 	return t.Time == nil
 }
@@ -18,3 +18,7 @@ func ParseSchedulePeriod(s string) (SchedulePeriod, error) {
 func (t SchedulePeriod) String() string {
 	return time.Duration(t).String()
 }
+
+func (t SchedulePeriod) Duration() time.Duration {
+	return time.Duration(t)
+}
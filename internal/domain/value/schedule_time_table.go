@@ -4,6 +4,7 @@ import "time"
 
 type ScheduleTimeTableItem struct {
 	time.Time
+	Night bool // outside of day window, only round-the-clock schedules have night items
 }
 
 func (t ScheduleTimeTableItem) String() string {
@@ -25,3 +26,14 @@ func (t ScheduleTimeTable) ToStringArray() []string {
 	}
 	return s
 }
+
+// Night returns items outside of day window.
+func (t ScheduleTimeTable) Night() ScheduleTimeTable {
+	var night ScheduleTimeTable
+	for _, item := range t {
+		if item.Night {
+			night = append(night, item)
+		}
+	}
+	return night
+}
@@ -0,0 +1,33 @@
+// Package metrics exposes prometheus metrics of application.
+package metrics
+
+import (
+	"database/sql"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"net/http"
+)
+
+const Path = "/metrics"
+
+// NewRegistry makes registry with go runtime and process collectors.
+func NewRegistry() *prometheus.Registry {
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+	return reg
+}
+
+// RegisterDBStats registers sql.DBStats pool gauges.
+func RegisterDBStats(reg prometheus.Registerer, db *sql.DB, driver string) {
+	reg.MustRegister(collectors.NewDBStatsCollector(db, driver))
+}
+
+func Handler(reg *prometheus.Registry) http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle(Path, promhttp.HandlerFor(reg, promhttp.HandlerOpts{Registry: reg}))
+	return mux
+}
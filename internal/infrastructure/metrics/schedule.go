@@ -0,0 +1,46 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// ScheduleMetrics implements schedule.Metrics.
+type ScheduleMetrics struct {
+	created        prometheus.Counter
+	nextTakings    prometheus.Counter
+	timetableSizes prometheus.Histogram
+}
+
+func NewScheduleMetrics(reg prometheus.Registerer) *ScheduleMetrics {
+	m := &ScheduleMetrics{
+		created: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "schedule_created_total",
+			Help: "Number of created schedules, including imported.",
+		}),
+		nextTakings: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "schedule_next_takings_computations_total",
+			Help: "Number of next takings computations.",
+		}),
+		timetableSizes: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Name:    "schedule_timetable_size",
+			Help:    "Number of takings in computed timetables.",
+			Buckets: []float64{0, 1, 2, 4, 8, 12, 16, 24, 48, 96},
+		}),
+	}
+
+	reg.MustRegister(m.created, m.nextTakings, m.timetableSizes)
+
+	return m
+}
+
+func (m *ScheduleMetrics) SchedulesCreated(n int) {
+	m.created.Add(float64(n))
+}
+
+func (m *ScheduleMetrics) NextTakingsComputed() {
+	m.nextTakings.Inc()
+}
+
+func (m *ScheduleMetrics) TimetableComputed(size int) {
+	m.timetableSizes.Observe(float64(size))
+}
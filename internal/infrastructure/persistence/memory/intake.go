@@ -0,0 +1,52 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"schedule/internal/domain/entity"
+	"schedule/internal/domain/value"
+	"slices"
+	"time"
+)
+
+func (r *ScheduleRepo) SaveIntake(_ context.Context, intake *entity.Intake) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	intake.Id = int64(len(r.intakes) + 1)
+
+	stored := *intake
+	stored.TakenAt = stored.TakenAt.UTC()
+	r.intakes = append(r.intakes, stored)
+
+	return nil
+}
+
+func (r *ScheduleRepo) GetIntakes(_ context.Context, userId value.UserId) ([]*entity.Intake, error) {
+	return r.getIntakes(func(intake entity.Intake) bool {
+		return intake.UserId == userId
+	}), nil
+}
+
+func (r *ScheduleRepo) GetIntakesBetween(_ context.Context, userId value.UserId, from, to time.Time) ([]*entity.Intake, error) {
+	return r.getIntakes(func(intake entity.Intake) bool {
+		return intake.UserId == userId && !intake.TakenAt.Before(from) && intake.TakenAt.Before(to)
+	}), nil
+}
+
+func (r *ScheduleRepo) getIntakes(match func(intake entity.Intake) bool) []*entity.Intake {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	intakes := make([]*entity.Intake, 0)
+	for _, intake := range r.intakes {
+		if match(intake) {
+			intakes = append(intakes, &intake)
+		}
+	}
+	slices.SortStableFunc(intakes, func(a, b *entity.Intake) int {
+		return cmp.Or(a.TakenAt.Compare(b.TakenAt), cmp.Compare(a.Id, b.Id))
+	})
+
+	return intakes
+}
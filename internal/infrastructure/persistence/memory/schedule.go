@@ -0,0 +1,270 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"schedule/internal/domain/aggregate"
+	"schedule/internal/domain/entity"
+	"schedule/internal/domain/value"
+	"schedule/internal/util"
+	"schedule/pkg/contextx"
+	"schedule/pkg/failure"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ScheduleRepo keeps schedules in memory, data is lost on restart.
+type ScheduleRepo struct {
+	mu        sync.RWMutex
+	lastId    value.ScheduleId
+	schedules map[value.ScheduleId]entity.Schedule
+	audit     []entity.AuditRecord
+	intakes   []entity.Intake
+	settings  map[value.UserId]entity.UserSettings
+	now       func() time.Time
+}
+
+// NewScheduleRepo makes repository, now stamps audit records, nil means time.Now.
+func NewScheduleRepo(now func() time.Time) *ScheduleRepo {
+	if now == nil {
+		now = time.Now
+	}
+	return &ScheduleRepo{
+		schedules: make(map[value.ScheduleId]entity.Schedule),
+		settings:  make(map[value.UserId]entity.UserSettings),
+		now:       now,
+	}
+}
+
+func (r *ScheduleRepo) Save(ctx context.Context, schedule *entity.Schedule) error {
+	return r.SaveAll(ctx, []*entity.Schedule{schedule})
+}
+
+func (r *ScheduleRepo) SaveAll(ctx context.Context, schedules []*entity.Schedule) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, schedule := range schedules {
+		r.lastId++
+		schedule.Id = r.lastId
+
+		stored := toStored(schedule)
+		r.schedules[schedule.Id] = stored
+		r.writeAudit(ctx, entity.AuditActionCreate, nil, &stored)
+	}
+
+	return nil
+}
+
+func (r *ScheduleRepo) GetByUser(_ context.Context, userId value.UserId) ([]*entity.Schedule, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var schedules []*entity.Schedule
+	for _, schedule := range r.schedules {
+		if schedule.UserId == userId {
+			schedules = append(schedules, fromStored(schedule))
+		}
+	}
+	slices.SortFunc(schedules, func(a, b *entity.Schedule) int {
+		return cmp.Compare(a.Id, b.Id)
+	})
+
+	return schedules, nil
+}
+
+func (r *ScheduleRepo) GetById(_ context.Context, userId value.UserId, scheduleId value.ScheduleId) (*entity.Schedule, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	schedule, ok := r.schedules[scheduleId]
+	if !ok || schedule.UserId != userId {
+		return nil, failure.NewNotFoundError("schedule not found")
+	}
+	return fromStored(schedule), nil
+}
+
+// GetOwner returns user of schedule, it is used when caller knows only schedule id.
+func (r *ScheduleRepo) GetOwner(_ context.Context, scheduleId value.ScheduleId) (value.UserId, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	schedule, ok := r.schedules[scheduleId]
+	if !ok {
+		return 0, failure.NewNotFoundError("schedule not found")
+	}
+	return schedule.UserId, nil
+}
+
+func (r *ScheduleRepo) Update(ctx context.Context, schedule *entity.Schedule) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	before, ok := r.schedules[schedule.Id]
+	if !ok || before.UserId != schedule.UserId {
+		return failure.NewNotFoundError("schedule not found")
+	}
+
+	stored := toStored(schedule)
+	r.schedules[schedule.Id] = stored
+	r.writeAudit(ctx, entity.AuditActionUpdate, &before, &stored)
+
+	return nil
+}
+
+func (r *ScheduleRepo) Delete(ctx context.Context, userId value.UserId, scheduleId value.ScheduleId) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	before, ok := r.schedules[scheduleId]
+	if !ok || before.UserId != userId {
+		return failure.NewNotFoundError("schedule not found")
+	}
+
+	delete(r.schedules, scheduleId)
+	r.writeAudit(ctx, entity.AuditActionDelete, &before, nil)
+
+	return nil
+}
+
+func (r *ScheduleRepo) GetHistory(_ context.Context, userId value.UserId, scheduleId value.ScheduleId) ([]*entity.AuditRecord, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	records := make([]*entity.AuditRecord, 0)
+	for _, record := range r.audit {
+		if record.UserId == userId && record.ScheduleId == scheduleId {
+			records = append(records, &record)
+		}
+	}
+	return records, nil
+}
+
+// noEndAtSortKey replaces nil end_at in sorting, schedules without end date are last in ascending order.
+var noEndAtSortKey = time.Date(9999, time.December, 31, 0, 0, 0, 0, time.UTC)
+
+func (r *ScheduleRepo) List(_ context.Context, query *aggregate.ScheduleListQuery) ([]*entity.Schedule, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	nameContains := strings.ToLower(query.NameContains)
+
+	var schedules []*entity.Schedule
+	for _, schedule := range r.schedules {
+		if schedule.UserId != query.UserId {
+			continue
+		}
+		if nameContains != "" && !strings.Contains(strings.ToLower(schedule.Name.String()), nameContains) {
+			continue
+		}
+
+		switch query.Status {
+		case aggregate.ScheduleStatusActive:
+			if !schedule.EndAt.IsNil() && schedule.EndAt.Before(query.ActiveSince) {
+				continue
+			}
+		case aggregate.ScheduleStatusExpired:
+			if schedule.EndAt.IsNil() || !schedule.EndAt.Before(query.ActiveSince) {
+				continue
+			}
+		}
+
+		if query.EndAtFrom != nil && (schedule.EndAt.IsNil() || schedule.EndAt.Before(*query.EndAtFrom)) {
+			continue
+		}
+		if query.EndAtTo != nil && (schedule.EndAt.IsNil() || schedule.EndAt.After(*query.EndAtTo)) {
+			continue
+		}
+
+		if after := query.After; after != nil {
+			c := compareListKeys(query.Sort, schedule.Name, endAtSortKey(schedule.EndAt.Time), schedule.Id, after.Name, endAtSortKey(after.EndAt), after.Id)
+			if query.Desc {
+				c = -c
+			}
+			if c <= 0 {
+				continue
+			}
+		}
+
+		schedules = append(schedules, fromStored(schedule))
+	}
+
+	slices.SortFunc(schedules, func(a, b *entity.Schedule) int {
+		c := compareListKeys(query.Sort, a.Name, endAtSortKey(a.EndAt.Time), a.Id, b.Name, endAtSortKey(b.EndAt.Time), b.Id)
+		if query.Desc {
+			return -c
+		}
+		return c
+	})
+
+	if len(schedules) > query.Limit {
+		schedules = schedules[:query.Limit]
+	}
+
+	return schedules, nil
+}
+
+// compareListKeys compares by sort field then by id, names are compared case-insensitive as in mysql.
+func compareListKeys(sort aggregate.ScheduleListSort, aName value.ScheduleName, aEndAt time.Time, aId value.ScheduleId, bName value.ScheduleName, bEndAt time.Time, bId value.ScheduleId) int {
+	var c int
+	switch sort {
+	case aggregate.ScheduleListSortName:
+		c = strings.Compare(strings.ToLower(aName.String()), strings.ToLower(bName.String()))
+	case aggregate.ScheduleListSortEndAt:
+		c = aEndAt.Compare(bEndAt)
+	}
+	if c != 0 {
+		return c
+	}
+	return cmp.Compare(aId, bId)
+}
+
+func endAtSortKey(endAt *time.Time) time.Time {
+	if endAt == nil {
+		return noEndAtSortKey
+	}
+	return *endAt
+}
+
+func (r *ScheduleRepo) writeAudit(ctx context.Context, action entity.AuditAction, before, after *entity.Schedule) {
+	schedule := after
+	if schedule == nil {
+		schedule = before
+	}
+
+	r.audit = append(r.audit, entity.AuditRecord{
+		Id:           int64(len(r.audit) + 1),
+		ScheduleId:   schedule.Id,
+		UserId:       schedule.UserId,
+		Actor:        string(contextx.GetActorOrDefault(ctx)),
+		ClaimedActor: string(contextx.GetClaimedActor(ctx)),
+		Action:       action,
+		Before:       entity.NewScheduleSnapshot(before),
+		After:        entity.NewScheduleSnapshot(after),
+		TraceId:      string(contextx.GetTraceId(ctx)),
+		CreatedAt:    r.now().UTC(),
+	})
+}
+
+// toStored copies schedule, end date is truncated to date as in DATE column.
+func toStored(schedule *entity.Schedule) entity.Schedule {
+	stored := *schedule
+	if !stored.EndAt.IsNil() {
+		endAt := stored.EndAt.UTC()
+		stored.EndAt = value.NewScheduleEndAt(util.Ptr(time.Date(endAt.Year(), endAt.Month(), endAt.Day(), 0, 0, 0, 0, time.UTC)))
+	}
+	if stored.AnchorAt != nil {
+		stored.AnchorAt = util.Ptr(stored.AnchorAt.UTC())
+	}
+	if stored.FinalDoseAt != nil {
+		stored.FinalDoseAt = util.Ptr(stored.FinalDoseAt.UTC())
+	}
+	stored.DaySlots = slices.Clone(stored.DaySlots)
+	return stored
+}
+
+func fromStored(schedule entity.Schedule) *entity.Schedule {
+	return &schedule
+}
@@ -0,0 +1,14 @@
+package memory
+
+import (
+	"schedule/internal/domain/usecase/schedule"
+	"schedule/internal/infrastructure/persistence/repotest"
+	"testing"
+	"time"
+)
+
+func TestScheduleRepo(t *testing.T) {
+	repotest.ScheduleRepoContract(t, func(t *testing.T, now func() time.Time) schedule.Repo {
+		return NewScheduleRepo(now)
+	})
+}
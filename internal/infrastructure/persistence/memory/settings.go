@@ -0,0 +1,27 @@
+package memory
+
+import (
+	"context"
+	"schedule/internal/domain/entity"
+	"schedule/internal/domain/value"
+)
+
+// GetSettings returns zero settings if user has not saved them.
+func (r *ScheduleRepo) GetSettings(_ context.Context, userId value.UserId) (*entity.UserSettings, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	settings, ok := r.settings[userId]
+	if !ok {
+		settings = entity.UserSettings{UserId: userId}
+	}
+	return &settings, nil
+}
+
+func (r *ScheduleRepo) SaveSettings(_ context.Context, settings *entity.UserSettings) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.settings[settings.UserId] = *settings
+	return nil
+}
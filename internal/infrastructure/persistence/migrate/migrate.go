@@ -0,0 +1,115 @@
+// Package migrate applies embedded migrations with goose.
+package migrate
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/pressly/goose/v3"
+	"github.com/pressly/goose/v3/lock"
+	migrations "schedule/db"
+	"schedule/internal/config"
+	"time"
+)
+
+// mysqlLockName is name of advisory lock held while migrations are applied.
+const mysqlLockName = "schedule_migrations"
+
+var dialects = map[string]goose.Dialect{
+	config.DriverMySQL:    goose.DialectMySQL,
+	config.DriverPostgres: goose.DialectPostgres,
+	config.DriverSQLite:   goose.DialectSQLite3,
+}
+
+// Up applies all pending migrations.
+func Up(ctx context.Context, db *sql.DB, driver string) ([]*goose.MigrationResult, error) {
+	provider, err := newProvider(db, driver)
+	if err != nil {
+		return nil, err
+	}
+	return provider.Up(ctx)
+}
+
+// Down rolls back last applied migration.
+func Down(ctx context.Context, db *sql.DB, driver string) (*goose.MigrationResult, error) {
+	provider, err := newProvider(db, driver)
+	if err != nil {
+		return nil, err
+	}
+	return provider.Down(ctx)
+}
+
+func Status(ctx context.Context, db *sql.DB, driver string) ([]*goose.MigrationStatus, error) {
+	provider, err := newProvider(db, driver)
+	if err != nil {
+		return nil, err
+	}
+	return provider.Status(ctx)
+}
+
+// UpLocked applies pending migrations holding database advisory lock,
+// so several replicas started at once don't race. Sqlite has single node and is not locked.
+func UpLocked(ctx context.Context, db *sql.DB, driver string, timeout time.Duration) ([]*goose.MigrationResult, error) {
+	switch driver {
+	case config.DriverMySQL:
+		unlock, err := lockMySQL(ctx, db, timeout)
+		if err != nil {
+			return nil, err
+		}
+		defer unlock()
+
+		return Up(ctx, db, driver)
+
+	case config.DriverPostgres:
+		locker, err := lock.NewPostgresSessionLocker(lock.WithLockTimeout(1, uint64(timeout.Seconds())))
+		if err != nil {
+			return nil, err
+		}
+
+		provider, err := newProvider(db, driver, goose.WithSessionLocker(locker))
+		if err != nil {
+			return nil, err
+		}
+		return provider.Up(ctx)
+	}
+
+	return Up(ctx, db, driver)
+}
+
+func newProvider(db *sql.DB, driver string, opts ...goose.ProviderOption) (*goose.Provider, error) {
+	dialect, ok := dialects[driver]
+	if !ok {
+		return nil, fmt.Errorf("driver '%s' has no migrations", driver)
+	}
+
+	fsys, err := migrations.Migrations(driver)
+	if err != nil {
+		return nil, err
+	}
+
+	return goose.NewProvider(dialect, db, fsys, opts...)
+}
+
+// lockMySQL takes named lock, which is held by connection until it is released or closed.
+func lockMySQL(ctx context.Context, db *sql.DB, timeout time.Duration) (unlock func(), err error) {
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var acquired sql.NullInt64
+	if err := conn.QueryRowContext(ctx, "SELECT GET_LOCK(?, ?)", mysqlLockName, int(timeout.Seconds())).Scan(&acquired); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("get lock: %w", err)
+	}
+	if acquired.Int64 != 1 {
+		conn.Close()
+		return nil, errors.New("migrations lock is held by another instance")
+	}
+
+	return func() {
+		conn.ExecContext(context.Background(), "SELECT RELEASE_LOCK(?)", mysqlLockName)
+		conn.Close()
+	}, nil
+}
@@ -5,7 +5,9 @@ import (
 	"fmt"
 	_ "github.com/go-sql-driver/mysql"
 	"github.com/jmoiron/sqlx"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
 	"schedule/internal/config"
+	"schedule/internal/infrastructure/persistence/sqltrace"
 	"time"
 )
 
@@ -15,7 +17,7 @@ func Connect(cfg config.MySqlConfig) (*sqlx.DB, error) {
 
 	dataSource := fmt.Sprintf("%s:%s@tcp(%s)/%s?parseTime=true&multiStatements=true", cfg.User, cfg.Password, cfg.Addr, cfg.Schema)
 
-	db, err := sqlx.ConnectContext(ctx, "mysql", dataSource)
+	db, err := sqltrace.ConnectContext(ctx, "mysql", dataSource, semconv.DBSystemMySQL)
 	if err != nil {
 		return nil, err
 	}
@@ -4,33 +4,72 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
 	"github.com/jmoiron/sqlx"
+	"schedule/internal/domain/aggregate"
 	"schedule/internal/domain/entity"
 	"schedule/internal/domain/value"
+	"schedule/pkg/contextx"
 	"schedule/pkg/failure"
+	"strings"
+	"time"
 )
 
 type ScheduleRepo struct {
-	db *sqlx.DB
+	db  *sqlx.DB
+	now func() time.Time
 }
 
-func NewScheduleRepo(db *sqlx.DB) *ScheduleRepo {
+// NewScheduleRepo makes repository, now stamps audit records, nil means time.Now.
+func NewScheduleRepo(db *sqlx.DB, now func() time.Time) *ScheduleRepo {
+	if now == nil {
+		now = time.Now
+	}
 	return &ScheduleRepo{
-		db: db,
+		db:  db,
+		now: now,
 	}
 }
 
+const insertScheduleQuery = "INSERT INTO schedule (user_id, name, end_at, period, round_the_clock, anchor_at, reanchor, day_slots, final_dose_at, consolidation_tolerance) VALUES (:user_id, :name, :end_at, :period, :round_the_clock, :anchor_at, :reanchor, :day_slots, :final_dose_at, :consolidation_tolerance)"
+
 func (r *ScheduleRepo) Save(ctx context.Context, schedule *entity.Schedule) error {
-	res, err := r.db.NamedExecContext(ctx, "INSERT INTO schedule (user_id, name, end_at, period) VALUES (:user_id, :name, :end_at, :period)", schedule)
+	return r.SaveAll(ctx, []*entity.Schedule{schedule})
+}
+
+func (r *ScheduleRepo) SaveAll(ctx context.Context, schedules []*entity.Schedule) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
 	if err != nil {
 		return failure.NewInternalError(err.Error())
 	}
+	defer tx.Rollback()
 
-	id, err := res.LastInsertId()
+	stmt, err := tx.PrepareNamedContext(ctx, insertScheduleQuery)
 	if err != nil {
 		return failure.NewInternalError(err.Error())
 	}
-	schedule.Id = value.ScheduleId(id)
+	defer stmt.Close()
+
+	for _, schedule := range schedules {
+		res, err := stmt.ExecContext(ctx, schedule)
+		if err != nil {
+			return failure.NewInternalError(err.Error())
+		}
+
+		id, err := res.LastInsertId()
+		if err != nil {
+			return failure.NewInternalError(err.Error())
+		}
+		schedule.Id = value.ScheduleId(id)
+
+		if err := r.writeAudit(ctx, tx, entity.AuditActionCreate, nil, schedule); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return failure.NewInternalError(err.Error())
+	}
 
 	return nil
 }
@@ -56,3 +95,199 @@ func (r *ScheduleRepo) GetById(ctx context.Context, userId value.UserId, schedul
 	}
 	return schedule, nil
 }
+
+// GetOwner returns user of schedule, it is used when caller knows only schedule id.
+func (r *ScheduleRepo) GetOwner(ctx context.Context, scheduleId value.ScheduleId) (value.UserId, error) {
+	var userId value.UserId
+	if err := r.db.GetContext(ctx, &userId, "SELECT user_id FROM schedule WHERE id = ?", scheduleId); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, failure.NewNotFoundError(err.Error())
+		}
+		return 0, failure.NewInternalError(err.Error())
+	}
+	return userId, nil
+}
+
+func (r *ScheduleRepo) Update(ctx context.Context, schedule *entity.Schedule) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return failure.NewInternalError(err.Error())
+	}
+	defer tx.Rollback()
+
+	before, err := getByIdForUpdate(ctx, tx, schedule.UserId, schedule.Id)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.NamedExecContext(ctx, "UPDATE schedule SET name = :name, end_at = :end_at, period = :period, round_the_clock = :round_the_clock, anchor_at = :anchor_at, reanchor = :reanchor, day_slots = :day_slots, final_dose_at = :final_dose_at, consolidation_tolerance = :consolidation_tolerance WHERE user_id = :user_id AND id = :id", schedule); err != nil {
+		return failure.NewInternalError(err.Error())
+	}
+
+	if err := r.writeAudit(ctx, tx, entity.AuditActionUpdate, before, schedule); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return failure.NewInternalError(err.Error())
+	}
+
+	return nil
+}
+
+func (r *ScheduleRepo) Delete(ctx context.Context, userId value.UserId, scheduleId value.ScheduleId) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return failure.NewInternalError(err.Error())
+	}
+	defer tx.Rollback()
+
+	before, err := getByIdForUpdate(ctx, tx, userId, scheduleId)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM schedule WHERE user_id = ? AND id = ?", userId, scheduleId); err != nil {
+		return failure.NewInternalError(err.Error())
+	}
+
+	if err := r.writeAudit(ctx, tx, entity.AuditActionDelete, before, nil); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return failure.NewInternalError(err.Error())
+	}
+
+	return nil
+}
+
+func (r *ScheduleRepo) GetHistory(ctx context.Context, userId value.UserId, scheduleId value.ScheduleId) ([]*entity.AuditRecord, error) {
+	records := make([]*entity.AuditRecord, 0)
+	if err := r.db.SelectContext(ctx, &records, "SELECT * FROM schedule_audit WHERE user_id = ? AND schedule_id = ? ORDER BY id", userId, scheduleId); err != nil {
+		return nil, failure.NewInternalError(err.Error())
+	}
+	return records, nil
+}
+
+func getByIdForUpdate(ctx context.Context, tx *sqlx.Tx, userId value.UserId, scheduleId value.ScheduleId) (*entity.Schedule, error) {
+	schedule := new(entity.Schedule)
+	if err := tx.GetContext(ctx, schedule, "SELECT * FROM schedule WHERE user_id = ? AND id = ? FOR UPDATE", userId, scheduleId); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, failure.NewNotFoundError("schedule not found")
+		}
+		return nil, failure.NewInternalError(err.Error())
+	}
+	return schedule, nil
+}
+
+// writeAudit appends audit record in transaction of change, before or after is nil for create and delete.
+func (r *ScheduleRepo) writeAudit(ctx context.Context, tx *sqlx.Tx, action entity.AuditAction, before, after *entity.Schedule) error {
+	schedule := after
+	if schedule == nil {
+		schedule = before
+	}
+
+	record := &entity.AuditRecord{
+		ScheduleId:   schedule.Id,
+		UserId:       schedule.UserId,
+		Actor:        string(contextx.GetActorOrDefault(ctx)),
+		ClaimedActor: string(contextx.GetClaimedActor(ctx)),
+		Action:       action,
+		Before:       entity.NewScheduleSnapshot(before),
+		After:        entity.NewScheduleSnapshot(after),
+		TraceId:      string(contextx.GetTraceId(ctx)),
+		CreatedAt:    r.now().UTC(),
+	}
+
+	if _, err := tx.NamedExecContext(ctx, "INSERT INTO schedule_audit (schedule_id, user_id, actor, claimed_actor, action, before_state, after_state, trace_id, created_at) VALUES (:schedule_id, :user_id, :actor, :claimed_actor, :action, :before_state, :after_state, :trace_id, :created_at)", record); err != nil {
+		return failure.NewInternalError(err.Error())
+	}
+
+	return nil
+}
+
+// noEndAtSortKey replaces NULL end_at in sorting, schedules without end date are last in ascending order.
+var noEndAtSortKey = time.Date(9999, time.December, 31, 0, 0, 0, 0, time.UTC)
+
+func (r *ScheduleRepo) List(ctx context.Context, query *aggregate.ScheduleListQuery) ([]*entity.Schedule, error) {
+	where := []string{"user_id = ?"}
+	args := []any{query.UserId}
+
+	if query.NameContains != "" {
+		where = append(where, "name LIKE ?")
+		args = append(args, "%"+escapeLike(query.NameContains)+"%")
+	}
+
+	switch query.Status {
+	case aggregate.ScheduleStatusActive:
+		where = append(where, "(end_at IS NULL OR end_at >= ?)")
+		args = append(args, query.ActiveSince)
+	case aggregate.ScheduleStatusExpired:
+		where = append(where, "end_at < ?")
+		args = append(args, query.ActiveSince)
+	}
+
+	if query.EndAtFrom != nil {
+		where = append(where, "end_at >= ?")
+		args = append(args, *query.EndAtFrom)
+	}
+	if query.EndAtTo != nil {
+		where = append(where, "end_at <= ?")
+		args = append(args, *query.EndAtTo)
+	}
+
+	var sortKey string
+	switch query.Sort {
+	case aggregate.ScheduleListSortName:
+		sortKey = "name"
+	case aggregate.ScheduleListSortEndAt:
+		sortKey = "COALESCE(end_at, ?)"
+	}
+
+	cmp, order := ">", "ASC"
+	if query.Desc {
+		cmp, order = "<", "DESC"
+	}
+
+	if after := query.After; after != nil {
+		switch query.Sort {
+		case aggregate.ScheduleListSortName:
+			where = append(where, fmt.Sprintf("(name %[1]s ? OR (name = ? AND id %[1]s ?))", cmp))
+			args = append(args, after.Name, after.Name, after.Id)
+		case aggregate.ScheduleListSortEndAt:
+			endAt := noEndAtSortKey
+			if after.EndAt != nil {
+				endAt = *after.EndAt
+			}
+			where = append(where, fmt.Sprintf("(COALESCE(end_at, ?) %[1]s ? OR (COALESCE(end_at, ?) = ? AND id %[1]s ?))", cmp))
+			args = append(args, noEndAtSortKey, endAt, noEndAtSortKey, endAt, after.Id)
+		default:
+			where = append(where, fmt.Sprintf("id %s ?", cmp))
+			args = append(args, after.Id)
+		}
+	}
+
+	orderBy := fmt.Sprintf("id %s", order)
+	if sortKey != "" {
+		orderBy = fmt.Sprintf("%s %s, %s", sortKey, order, orderBy)
+		if query.Sort == aggregate.ScheduleListSortEndAt {
+			args = append(args, noEndAtSortKey)
+		}
+	}
+
+	q := fmt.Sprintf("SELECT * FROM schedule WHERE %s ORDER BY %s LIMIT ?", strings.Join(where, " AND "), orderBy)
+	args = append(args, query.Limit)
+
+	schedules := make([]*entity.Schedule, 0)
+	if err := r.db.SelectContext(ctx, &schedules, q, args...); err != nil {
+		return nil, failure.NewInternalError(err.Error())
+	}
+	return schedules, nil
+}
+
+var likeReplacer = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+func escapeLike(s string) string {
+	return likeReplacer.Replace(s)
+}
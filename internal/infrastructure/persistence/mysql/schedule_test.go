@@ -0,0 +1,31 @@
+package mysql
+
+import (
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+	"os"
+	"schedule/internal/domain/usecase/schedule"
+	"schedule/internal/infrastructure/persistence/repotest"
+	"testing"
+	"time"
+)
+
+// TestScheduleRepo runs contract tests on migrated database from TEST_MYSQL_DSN,
+// e.g. root:password@tcp(localhost:3306)/schedule?parseTime=true&multiStatements=true
+func TestScheduleRepo(t *testing.T) {
+	dsn := os.Getenv("TEST_MYSQL_DSN")
+	if testing.Short() || dsn == "" {
+		t.Skip("skipping mysql repository test, TEST_MYSQL_DSN is not set")
+	}
+
+	db, err := sqlx.Connect("mysql", dsn)
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	repotest.ScheduleRepoContract(t, func(t *testing.T, now func() time.Time) schedule.Repo {
+		_, err := db.Exec("DELETE FROM schedule; TRUNCATE TABLE schedule_audit; TRUNCATE TABLE user_settings; TRUNCATE TABLE schedule_intake;")
+		require.NoError(t, err)
+
+		return NewScheduleRepo(db, now)
+	})
+}
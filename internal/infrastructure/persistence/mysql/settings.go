@@ -0,0 +1,29 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"schedule/internal/domain/entity"
+	"schedule/internal/domain/value"
+	"schedule/pkg/failure"
+)
+
+// GetSettings returns zero settings if user has not saved them.
+func (r *ScheduleRepo) GetSettings(ctx context.Context, userId value.UserId) (*entity.UserSettings, error) {
+	settings := &entity.UserSettings{UserId: userId}
+	if err := r.db.GetContext(ctx, settings, "SELECT * FROM user_settings WHERE user_id = ?", userId); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return settings, nil
+		}
+		return nil, failure.NewInternalError(err.Error())
+	}
+	return settings, nil
+}
+
+func (r *ScheduleRepo) SaveSettings(ctx context.Context, settings *entity.UserSettings) error {
+	if _, err := r.db.NamedExecContext(ctx, "INSERT INTO user_settings (user_id, consolidate_reminders) VALUES (:user_id, :consolidate_reminders) ON DUPLICATE KEY UPDATE consolidate_reminders = VALUES(consolidate_reminders)", settings); err != nil {
+		return failure.NewInternalError(err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,32 @@
+package postgres
+
+import (
+	"context"
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/jmoiron/sqlx"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"net/url"
+	"schedule/internal/config"
+	"schedule/internal/infrastructure/persistence/sqltrace"
+	"time"
+)
+
+func Connect(cfg config.PostgresConfig) (*sqlx.DB, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(cfg.ConnectTimeout)*time.Second)
+	defer cancel()
+
+	dataSource := url.URL{
+		Scheme:   "postgres",
+		User:     url.UserPassword(cfg.User, cfg.Password),
+		Host:     cfg.Addr,
+		Path:     cfg.Database,
+		RawQuery: url.Values{"sslmode": {cfg.SSLMode}}.Encode(),
+	}
+
+	db, err := sqltrace.ConnectContext(ctx, "pgx", dataSource.String(), semconv.DBSystemPostgreSQL)
+	if err != nil {
+		return nil, err
+	}
+
+	return db, nil
+}
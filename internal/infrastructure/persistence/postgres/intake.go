@@ -0,0 +1,39 @@
+package postgres
+
+import (
+	"context"
+	"schedule/internal/domain/entity"
+	"schedule/internal/domain/value"
+	"schedule/pkg/failure"
+	"time"
+)
+
+func (r *ScheduleRepo) SaveIntake(ctx context.Context, intake *entity.Intake) error {
+	stmt, err := r.db.PrepareNamedContext(ctx, "INSERT INTO schedule_intake (schedule_id, user_id, name, taken_at) VALUES (:schedule_id, :user_id, :name, :taken_at) RETURNING id")
+	if err != nil {
+		return failure.NewInternalError(err.Error())
+	}
+	defer stmt.Close()
+
+	if err := stmt.QueryRowxContext(ctx, intake).Scan(&intake.Id); err != nil {
+		return failure.NewInternalError(err.Error())
+	}
+
+	return nil
+}
+
+func (r *ScheduleRepo) GetIntakes(ctx context.Context, userId value.UserId) ([]*entity.Intake, error) {
+	intakes := make([]*entity.Intake, 0)
+	if err := r.db.SelectContext(ctx, &intakes, "SELECT * FROM schedule_intake WHERE user_id = $1 ORDER BY taken_at, id", userId); err != nil {
+		return nil, failure.NewInternalError(err.Error())
+	}
+	return intakes, nil
+}
+
+func (r *ScheduleRepo) GetIntakesBetween(ctx context.Context, userId value.UserId, from, to time.Time) ([]*entity.Intake, error) {
+	intakes := make([]*entity.Intake, 0)
+	if err := r.db.SelectContext(ctx, &intakes, "SELECT * FROM schedule_intake WHERE user_id = $1 AND taken_at >= $2 AND taken_at < $3 ORDER BY taken_at, id", userId, from.UTC(), to.UTC()); err != nil {
+		return nil, failure.NewInternalError(err.Error())
+	}
+	return intakes, nil
+}
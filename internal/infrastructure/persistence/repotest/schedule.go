@@ -0,0 +1,368 @@
+// Package repotest contains contract tests which every repository implementation must pass.
+package repotest
+
+import (
+	"context"
+	"github.com/stretchr/testify/require"
+	"schedule/internal/domain/aggregate"
+	"schedule/internal/domain/entity"
+	"schedule/internal/domain/usecase/schedule"
+	"schedule/internal/domain/value"
+	"schedule/internal/util"
+	"schedule/pkg/contextx"
+	"schedule/pkg/failure"
+	"testing"
+	"time"
+)
+
+const (
+	userId      = value.UserId(1000000000000000)
+	otherUserId = value.UserId(1000000000000001)
+)
+
+var testNow = time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+// ScheduleRepoContract runs tests of schedule.Repo behaviour.
+// newRepo must return repository with empty storage which stamps audit records by now, it is called for each test.
+func ScheduleRepoContract(t *testing.T, newRepo func(t *testing.T, now func() time.Time) schedule.Repo) {
+	clock := func() time.Time { return testNow }
+
+	t.Run("save and get by id", func(t *testing.T) {
+		repo := newRepo(t, clock)
+		ctx := context.Background()
+
+		s := newTestSchedule("Test name", date(2025, time.January, 10))
+		require.NoError(t, repo.Save(ctx, s))
+		require.NotZero(t, s.Id)
+
+		got, err := repo.GetById(ctx, userId, s.Id)
+		require.NoError(t, err)
+		requireScheduleEqual(t, s, got)
+
+		_, err = repo.GetById(ctx, otherUserId, s.Id)
+		require.True(t, failure.IsNotFoundError(err), "expected not found, got %v", err)
+
+		_, err = repo.GetById(ctx, userId, s.Id+1)
+		require.True(t, failure.IsNotFoundError(err), "expected not found, got %v", err)
+	})
+
+	t.Run("save all and get by user", func(t *testing.T) {
+		repo := newRepo(t, clock)
+		ctx := context.Background()
+
+		schedules := []*entity.Schedule{
+			newTestSchedule("First", nil),
+			newTestSchedule("Second", date(2025, time.January, 10)),
+			newTestSchedule("Third", nil),
+		}
+		require.NoError(t, repo.SaveAll(ctx, schedules))
+		require.NoError(t, repo.Save(ctx, &entity.Schedule{UserId: otherUserId, Name: "Other", Period: value.SchedulePeriod(time.Hour)}))
+
+		got, err := repo.GetByUser(ctx, userId)
+		require.NoError(t, err)
+		require.ElementsMatch(t, scheduleIds(schedules), scheduleIds(got))
+
+		got, err = repo.GetByUser(ctx, userId+2)
+		require.NoError(t, err)
+		require.Empty(t, got)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		repo := newRepo(t, clock)
+		ctx := context.Background()
+
+		s := newTestSchedule("Test name", date(2025, time.January, 10))
+		require.NoError(t, repo.Save(ctx, s))
+
+		s.Name = "Updated name"
+		s.Period = value.SchedulePeriod(time.Hour * 4)
+		s.EndAt = value.NewScheduleEndAt(nil)
+		s.RoundTheClock = true
+		s.AnchorAt = util.Ptr(time.Date(2025, time.January, 10, 8, 0, 0, 0, time.UTC))
+		s.Reanchor = true
+		s.DaySlots = value.ScheduleDaySlots{time.Hour * 8, time.Hour*15 + time.Minute*30}
+		s.FinalDoseAt = util.Ptr(time.Date(2025, time.January, 20, 15, 30, 0, 0, time.UTC))
+		s.ConsolidationTolerance = util.Ptr(time.Hour)
+		require.NoError(t, repo.Update(ctx, s))
+
+		got, err := repo.GetById(ctx, userId, s.Id)
+		require.NoError(t, err)
+		requireScheduleEqual(t, s, got)
+
+		other := *s
+		other.UserId = otherUserId
+		err = repo.Update(ctx, &other)
+		require.True(t, failure.IsNotFoundError(err), "expected not found, got %v", err)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		repo := newRepo(t, clock)
+		ctx := context.Background()
+
+		s := newTestSchedule("Test name", nil)
+		require.NoError(t, repo.Save(ctx, s))
+
+		err := repo.Delete(ctx, otherUserId, s.Id)
+		require.True(t, failure.IsNotFoundError(err), "expected not found, got %v", err)
+
+		require.NoError(t, repo.Delete(ctx, userId, s.Id))
+
+		_, err = repo.GetById(ctx, userId, s.Id)
+		require.True(t, failure.IsNotFoundError(err), "expected not found, got %v", err)
+
+		err = repo.Delete(ctx, userId, s.Id)
+		require.True(t, failure.IsNotFoundError(err), "expected not found, got %v", err)
+	})
+
+	t.Run("history", func(t *testing.T) {
+		repo := newRepo(t, clock)
+		ctx := contextx.WithActor(contextx.WithTraceId(context.Background(), "trace"), "doctor")
+		ctx = contextx.WithClaimedActor(ctx, "doctor:42")
+
+		s := newTestSchedule("Test name", nil)
+		require.NoError(t, repo.Save(ctx, s))
+		created := entity.NewScheduleSnapshot(s)
+
+		s.Name = "Updated name"
+		require.NoError(t, repo.Update(ctx, s))
+		updated := entity.NewScheduleSnapshot(s)
+
+		require.NoError(t, repo.Delete(context.Background(), userId, s.Id))
+
+		records, err := repo.GetHistory(ctx, userId, s.Id)
+		require.NoError(t, err)
+		require.Len(t, records, 3)
+
+		expected := []struct {
+			action  entity.AuditAction
+			before  *entity.ScheduleSnapshot
+			after   *entity.ScheduleSnapshot
+			actor   string
+			claimed string
+			traceId string
+		}{
+			{action: entity.AuditActionCreate, after: created, actor: "doctor", claimed: "doctor:42", traceId: "trace"},
+			{action: entity.AuditActionUpdate, before: created, after: updated, actor: "doctor", claimed: "doctor:42", traceId: "trace"},
+			{action: entity.AuditActionDelete, before: updated, actor: string(contextx.AnonymousActor)},
+		}
+		for i, record := range records {
+			require.Equal(t, s.Id, record.ScheduleId)
+			require.Equal(t, expected[i].action, record.Action)
+			require.Equal(t, expected[i].before, record.Before)
+			require.Equal(t, expected[i].after, record.After)
+			require.Equal(t, expected[i].actor, record.Actor)
+			require.Equal(t, expected[i].claimed, record.ClaimedActor)
+			require.Equal(t, expected[i].traceId, record.TraceId)
+			require.True(t, testNow.Equal(record.CreatedAt), "expected created at %s, got %s", testNow, record.CreatedAt)
+		}
+
+		records, err = repo.GetHistory(ctx, otherUserId, s.Id)
+		require.NoError(t, err)
+		require.Empty(t, records)
+	})
+
+	t.Run("owner", func(t *testing.T) {
+		repo := newRepo(t, clock)
+		ctx := context.Background()
+
+		s := newTestSchedule("Test name", nil)
+		require.NoError(t, repo.Save(ctx, s))
+
+		got, err := repo.GetOwner(ctx, s.Id)
+		require.NoError(t, err)
+		require.Equal(t, userId, got)
+
+		_, err = repo.GetOwner(ctx, s.Id+1)
+		require.True(t, failure.IsNotFoundError(err), "expected not found, got %v", err)
+	})
+
+	t.Run("intakes", func(t *testing.T) {
+		repo := newRepo(t, clock)
+		ctx := context.Background()
+
+		s := newTestSchedule("Test name", nil)
+		require.NoError(t, repo.Save(ctx, s))
+
+		late := &entity.Intake{ScheduleId: s.Id, UserId: userId, Name: s.Name, TakenAt: testNow}
+		early := &entity.Intake{ScheduleId: s.Id, UserId: userId, Name: s.Name, TakenAt: testNow.Add(-time.Hour)}
+		require.NoError(t, repo.SaveIntake(ctx, late))
+		require.NoError(t, repo.SaveIntake(ctx, early))
+		require.NoError(t, repo.SaveIntake(ctx, &entity.Intake{ScheduleId: s.Id, UserId: otherUserId, Name: s.Name, TakenAt: testNow}))
+		require.NotZero(t, late.Id)
+
+		require.NoError(t, repo.Delete(ctx, userId, s.Id))
+
+		intakes, err := repo.GetIntakes(ctx, userId)
+		require.NoError(t, err)
+		require.Len(t, intakes, 2)
+		for i, expected := range []*entity.Intake{early, late} {
+			require.Equal(t, expected.Id, intakes[i].Id)
+			require.Equal(t, s.Id, intakes[i].ScheduleId)
+			require.Equal(t, s.Name, intakes[i].Name)
+			require.True(t, expected.TakenAt.Equal(intakes[i].TakenAt), "expected taken at %s, got %s", expected.TakenAt, intakes[i].TakenAt)
+		}
+
+		intakes, err = repo.GetIntakesBetween(ctx, userId, testNow.Add(-time.Hour), testNow)
+		require.NoError(t, err)
+		require.Len(t, intakes, 1, "end is excluded")
+		require.Equal(t, early.Id, intakes[0].Id)
+
+		intakes, err = repo.GetIntakesBetween(ctx, userId, testNow.Add(-time.Minute), testNow.Add(time.Minute))
+		require.NoError(t, err)
+		require.Len(t, intakes, 1)
+		require.Equal(t, late.Id, intakes[0].Id)
+	})
+
+	t.Run("settings", func(t *testing.T) {
+		repo := newRepo(t, clock)
+		ctx := context.Background()
+
+		got, err := repo.GetSettings(ctx, userId)
+		require.NoError(t, err)
+		require.Equal(t, &entity.UserSettings{UserId: userId}, got)
+
+		require.NoError(t, repo.SaveSettings(ctx, &entity.UserSettings{UserId: userId, ConsolidateReminders: true}))
+		got, err = repo.GetSettings(ctx, userId)
+		require.NoError(t, err)
+		require.True(t, got.ConsolidateReminders)
+
+		got, err = repo.GetSettings(ctx, otherUserId)
+		require.NoError(t, err)
+		require.False(t, got.ConsolidateReminders)
+
+		require.NoError(t, repo.SaveSettings(ctx, &entity.UserSettings{UserId: userId}))
+		got, err = repo.GetSettings(ctx, userId)
+		require.NoError(t, err)
+		require.False(t, got.ConsolidateReminders)
+	})
+
+	t.Run("list", func(t *testing.T) {
+		repo := newRepo(t, clock)
+		ctx := context.Background()
+
+		schedules := []*entity.Schedule{
+			newTestSchedule("aspirin", date(2025, time.January, 5)),
+			newTestSchedule("ibuprofen", nil),
+			newTestSchedule("vitamin D", date(2024, time.December, 1)),
+			newTestSchedule("vitamin_E 100%", date(2025, time.February, 1)),
+		}
+		require.NoError(t, repo.SaveAll(ctx, schedules))
+		require.NoError(t, repo.Save(ctx, &entity.Schedule{UserId: otherUserId, Name: "aspirin", Period: value.SchedulePeriod(time.Hour)}))
+
+		ids := scheduleIds(schedules)
+		activeSince := *date(2025, time.January, 1)
+
+		testCases := []struct {
+			name     string
+			query    aggregate.ScheduleListQuery
+			expected []value.ScheduleId
+		}{
+			{
+				name:     "all by id",
+				query:    aggregate.ScheduleListQuery{ScheduleListFilter: aggregate.ScheduleListFilter{Limit: 10}},
+				expected: ids,
+			},
+			{
+				name:     "active",
+				query:    aggregate.ScheduleListQuery{ScheduleListFilter: aggregate.ScheduleListFilter{Status: aggregate.ScheduleStatusActive, Limit: 10}, ActiveSince: activeSince},
+				expected: []value.ScheduleId{ids[0], ids[1], ids[3]},
+			},
+			{
+				name:     "expired",
+				query:    aggregate.ScheduleListQuery{ScheduleListFilter: aggregate.ScheduleListFilter{Status: aggregate.ScheduleStatusExpired, Limit: 10}, ActiveSince: activeSince},
+				expected: []value.ScheduleId{ids[2]},
+			},
+			{
+				name:     "name case-insensitive",
+				query:    aggregate.ScheduleListQuery{ScheduleListFilter: aggregate.ScheduleListFilter{NameContains: "VITAMIN", Limit: 10}},
+				expected: []value.ScheduleId{ids[2], ids[3]},
+			},
+			{
+				name:     "name with wildcards",
+				query:    aggregate.ScheduleListQuery{ScheduleListFilter: aggregate.ScheduleListFilter{NameContains: "_E 100%", Limit: 10}},
+				expected: []value.ScheduleId{ids[3]},
+			},
+			{
+				name:     "end at range",
+				query:    aggregate.ScheduleListQuery{ScheduleListFilter: aggregate.ScheduleListFilter{EndAtFrom: date(2025, time.January, 1), EndAtTo: date(2025, time.February, 1), Limit: 10}},
+				expected: []value.ScheduleId{ids[0], ids[3]},
+			},
+			{
+				name:     "sort by end at, without end date last",
+				query:    aggregate.ScheduleListQuery{ScheduleListFilter: aggregate.ScheduleListFilter{Sort: aggregate.ScheduleListSortEndAt, Limit: 10}},
+				expected: []value.ScheduleId{ids[2], ids[0], ids[3], ids[1]},
+			},
+			{
+				name:     "sort by end at desc after cursor",
+				query:    aggregate.ScheduleListQuery{ScheduleListFilter: aggregate.ScheduleListFilter{Sort: aggregate.ScheduleListSortEndAt, Desc: true, Limit: 2}, After: &aggregate.ScheduleListCursor{Id: ids[1]}},
+				expected: []value.ScheduleId{ids[3], ids[0]},
+			},
+			{
+				name:     "sort by name after cursor",
+				query:    aggregate.ScheduleListQuery{ScheduleListFilter: aggregate.ScheduleListFilter{Sort: aggregate.ScheduleListSortName, Limit: 10}, After: &aggregate.ScheduleListCursor{Id: ids[1], Name: "ibuprofen"}},
+				expected: []value.ScheduleId{ids[2], ids[3]},
+			},
+			{
+				name:     "limit desc",
+				query:    aggregate.ScheduleListQuery{ScheduleListFilter: aggregate.ScheduleListFilter{Desc: true, Limit: 2}},
+				expected: []value.ScheduleId{ids[3], ids[2]},
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				tc.query.UserId = userId
+
+				got, err := repo.List(ctx, &tc.query)
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, scheduleIds(got))
+			})
+		}
+	})
+}
+
+func newTestSchedule(name value.ScheduleName, endAt *time.Time) *entity.Schedule {
+	return &entity.Schedule{
+		UserId: userId,
+		Name:   name,
+		EndAt:  value.NewScheduleEndAt(endAt),
+		Period: value.SchedulePeriod(time.Hour * 8),
+	}
+}
+
+func date(year int, month time.Month, day int) *time.Time {
+	return util.Ptr(time.Date(year, month, day, 0, 0, 0, 0, time.UTC))
+}
+
+func scheduleIds(schedules []*entity.Schedule) []value.ScheduleId {
+	ids := make([]value.ScheduleId, len(schedules))
+	for i, s := range schedules {
+		ids[i] = s.Id
+	}
+	return ids
+}
+
+func requireScheduleEqual(t *testing.T, expected, actual *entity.Schedule) {
+	t.Helper()
+
+	require.Equal(t, expected.Id, actual.Id)
+	require.Equal(t, expected.UserId, actual.UserId)
+	require.Equal(t, expected.Name, actual.Name)
+	require.Equal(t, expected.Period, actual.Period)
+	require.Equal(t, expected.RoundTheClock, actual.RoundTheClock)
+	require.Equal(t, expected.Reanchor, actual.Reanchor)
+	require.Equal(t, expected.DaySlots, actual.DaySlots)
+	require.Equal(t, expected.ConsolidationTolerance, actual.ConsolidationTolerance)
+	require.Equal(t, expected.AnchorAt == nil, actual.AnchorAt == nil)
+	if expected.AnchorAt != nil {
+		require.True(t, expected.AnchorAt.Equal(*actual.AnchorAt), "expected anchor at %s, got %s", expected.AnchorAt, actual.AnchorAt)
+	}
+	require.Equal(t, expected.FinalDoseAt == nil, actual.FinalDoseAt == nil)
+	if expected.FinalDoseAt != nil {
+		require.True(t, expected.FinalDoseAt.Equal(*actual.FinalDoseAt), "expected final dose at %s, got %s", expected.FinalDoseAt, actual.FinalDoseAt)
+	}
+	require.Equal(t, expected.EndAt.IsNil(), actual.EndAt.IsNil())
+	if !expected.EndAt.IsNil() {
+		require.True(t, expected.EndAt.Equal(actual.EndAt.ToTime()), "expected end at %s, got %s", expected.EndAt, actual.EndAt)
+	}
+}
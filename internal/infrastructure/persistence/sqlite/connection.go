@@ -0,0 +1,42 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	_ "modernc.org/sqlite"
+	"net/url"
+	"schedule/internal/config"
+	"schedule/internal/infrastructure/persistence/sqltrace"
+	"time"
+)
+
+const driverName = "sqlite"
+
+func init() {
+	sqlx.BindDriver(driverName, sqlx.QUESTION)
+}
+
+// Connect opens database file.
+// Path ":memory:" opens database which lives until connection is closed.
+func Connect(cfg config.SQLiteConfig) (*sqlx.DB, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(cfg.ConnectTimeout)*time.Second)
+	defer cancel()
+
+	params := url.Values{
+		"_pragma":      {"foreign_keys(1)", "busy_timeout(5000)"},
+		"_time_format": {"sqlite"}, // time is written in format which sqlite date functions understand
+	}
+	dataSource := fmt.Sprintf("file:%s?%s", cfg.Path, params.Encode())
+
+	db, err := sqltrace.ConnectContext(ctx, driverName, dataSource, semconv.DBSystemSqlite)
+	if err != nil {
+		return nil, err
+	}
+
+	// sqlite has single writer, and each connection to ":memory:" is separate database
+	db.SetMaxOpenConns(1)
+
+	return db, nil
+}
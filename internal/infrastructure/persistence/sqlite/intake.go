@@ -0,0 +1,40 @@
+package sqlite
+
+import (
+	"context"
+	"schedule/internal/domain/entity"
+	"schedule/internal/domain/value"
+	"schedule/pkg/failure"
+	"time"
+)
+
+func (r *ScheduleRepo) SaveIntake(ctx context.Context, intake *entity.Intake) error {
+	res, err := r.db.NamedExecContext(ctx, "INSERT INTO schedule_intake (schedule_id, user_id, name, taken_at) VALUES (:schedule_id, :user_id, :name, :taken_at)", intake)
+	if err != nil {
+		return failure.NewInternalError(err.Error())
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil {
+		return failure.NewInternalError(err.Error())
+	}
+	intake.Id = id
+
+	return nil
+}
+
+func (r *ScheduleRepo) GetIntakes(ctx context.Context, userId value.UserId) ([]*entity.Intake, error) {
+	intakes := make([]*entity.Intake, 0)
+	if err := r.db.SelectContext(ctx, &intakes, "SELECT * FROM schedule_intake WHERE user_id = ? ORDER BY taken_at, id", userId); err != nil {
+		return nil, failure.NewInternalError(err.Error())
+	}
+	return intakes, nil
+}
+
+func (r *ScheduleRepo) GetIntakesBetween(ctx context.Context, userId value.UserId, from, to time.Time) ([]*entity.Intake, error) {
+	intakes := make([]*entity.Intake, 0)
+	if err := r.db.SelectContext(ctx, &intakes, "SELECT * FROM schedule_intake WHERE user_id = ? AND julianday(taken_at) >= julianday(?) AND julianday(taken_at) < julianday(?) ORDER BY taken_at, id", userId, from.UTC(), to.UTC()); err != nil {
+		return nil, failure.NewInternalError(err.Error())
+	}
+	return intakes, nil
+}
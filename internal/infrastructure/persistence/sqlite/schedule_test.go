@@ -0,0 +1,25 @@
+package sqlite
+
+import (
+	"context"
+	"github.com/stretchr/testify/require"
+	"schedule/internal/config"
+	"schedule/internal/domain/usecase/schedule"
+	"schedule/internal/infrastructure/persistence/migrate"
+	"schedule/internal/infrastructure/persistence/repotest"
+	"testing"
+	"time"
+)
+
+func TestScheduleRepo(t *testing.T) {
+	repotest.ScheduleRepoContract(t, func(t *testing.T, now func() time.Time) schedule.Repo {
+		db, err := Connect(config.SQLiteConfig{Path: ":memory:", ConnectTimeout: 10})
+		require.NoError(t, err)
+		t.Cleanup(func() { db.Close() })
+
+		_, err = migrate.Up(context.Background(), db.DB, config.DriverSQLite)
+		require.NoError(t, err)
+
+		return NewScheduleRepo(db, now)
+	})
+}
@@ -0,0 +1,39 @@
+// Package sqltrace opens databases which make opentelemetry spans for queries.
+package sqltrace
+
+import (
+	"context"
+	"database/sql/driver"
+	"github.com/XSAM/otelsql"
+	"github.com/jmoiron/sqlx"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var spanOptions = otelsql.SpanOptions{
+	OmitConnResetSession: true,
+	OmitRows:             true,
+	// queries outside of traced request (migrations, health checks) are not traced
+	SpanFilter: func(ctx context.Context, _ otelsql.Method, _ string, _ []driver.NamedValue) bool {
+		return trace.SpanContextFromContext(ctx).IsValid()
+	},
+}
+
+// ConnectContext is sqlx.ConnectContext with traced driver, system is semconv db.system attribute.
+func ConnectContext(ctx context.Context, driverName, dataSource string, system attribute.KeyValue) (*sqlx.DB, error) {
+	db, err := otelsql.Open(driverName, dataSource,
+		otelsql.WithAttributes(system),
+		otelsql.WithSpanOptions(spanOptions),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := db.PingContext(ctx); err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	// driver name is kept, sqlx uses it to choose bind type
+	return sqlx.NewDb(db, driverName), nil
+}
@@ -1,7 +1,14 @@
 package grpcserver
 
 import (
+	"context"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+	"log/slog"
+	"schedule/pkg/contextx"
+	"schedule/pkg/errcodes"
 	"schedule/pkg/failure"
 )
 
@@ -17,3 +24,61 @@ func getCodeFromError(err error) codes.Code {
 		return codes.Internal
 	}
 }
+
+// handleError logs error and converts it to status with details.
+func handleError(ctx context.Context, err error) error {
+	l := contextx.GetLoggerOrDefault(ctx)
+	l.LogAttrs(ctx, slog.LevelError, "handling request error", slog.String("err", err.Error()))
+
+	return newStatusError(ctx, err)
+}
+
+// newStatusError converts error to status with google.rpc.ErrorInfo and google.rpc.BadRequest details.
+// Internal error details are not exposed.
+func newStatusError(ctx context.Context, err error) error {
+	code := getCodeFromError(err)
+
+	reason, msg := errcodes.Internal, "internal error"
+	if code != codes.Internal {
+		reason, msg = failure.GetReason(err), failure.GetDetail(err)
+	}
+
+	errorInfo := &errdetails.ErrorInfo{
+		Reason: reason.String(),
+		Domain: errcodes.Domain,
+	}
+	if traceId := contextx.GetTraceId(ctx); traceId != "" {
+		errorInfo.Metadata = map[string]string{
+			"trace_id": string(traceId),
+		}
+	}
+
+	details := []protoadapt.MessageV1{errorInfo}
+
+	if violations := failure.GetViolations(err); len(violations) > 0 {
+		badRequest := &errdetails.BadRequest{
+			FieldViolations: make([]*errdetails.BadRequest_FieldViolation, len(violations)),
+		}
+		for i, v := range violations {
+			badRequest.FieldViolations[i] = &errdetails.BadRequest_FieldViolation{
+				Field:       v.Field,
+				Description: v.Description,
+			}
+		}
+		details = append(details, badRequest)
+	}
+
+	st := status.New(code, msg)
+	if withDetails, err := st.WithDetails(details...); err == nil {
+		st = withDetails
+	}
+
+	return st.Err()
+}
+
+func newRequiredFieldError(ctx context.Context, field, description string) error {
+	return newStatusError(ctx, failure.NewValidationError(failure.Violation{
+		Field:       field,
+		Description: description,
+	}))
+}
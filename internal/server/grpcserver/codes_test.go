@@ -0,0 +1,88 @@
+package grpcserver
+
+import (
+	"context"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"schedule/pkg/contextx"
+	"schedule/pkg/errcodes"
+	"schedule/pkg/failure"
+	"testing"
+)
+
+func TestNewStatusError(t *testing.T) {
+	ctx := contextx.WithTraceId(context.Background(), "trace")
+
+	testCases := []struct {
+		Name               string
+		Err                error
+		ExpectedCode       codes.Code
+		ExpectedMessage    string
+		ExpectedReason     errcodes.Code
+		ExpectedViolations []*errdetails.BadRequest_FieldViolation
+	}{
+		{
+			Name: "validation",
+			Err: failure.NewValidationError(
+				failure.Violation{Field: "name", Description: "name is required"},
+				failure.Violation{Field: "period", Description: "period is too short"},
+			),
+			ExpectedCode:    codes.InvalidArgument,
+			ExpectedMessage: "name is required; period is too short",
+			ExpectedReason:  errcodes.Validation,
+			ExpectedViolations: []*errdetails.BadRequest_FieldViolation{
+				{Field: "name", Description: "name is required"},
+				{Field: "period", Description: "period is too short"},
+			},
+		},
+		{
+			Name:            "not found",
+			Err:             failure.NewNotFoundError("schedule not found"),
+			ExpectedCode:    codes.NotFound,
+			ExpectedMessage: "schedule not found",
+			ExpectedReason:  errcodes.NotFound,
+		},
+		{
+			Name:            "internal",
+			Err:             failure.NewInternalError("connection refused"),
+			ExpectedCode:    codes.Internal,
+			ExpectedMessage: "internal error",
+			ExpectedReason:  errcodes.Internal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			st := status.Convert(newStatusError(ctx, tc.Err))
+
+			require.Equal(t, tc.ExpectedCode, st.Code())
+			require.Equal(t, tc.ExpectedMessage, st.Message())
+
+			var (
+				errorInfo  *errdetails.ErrorInfo
+				violations []*errdetails.BadRequest_FieldViolation
+			)
+			for _, detail := range st.Details() {
+				switch d := detail.(type) {
+				case *errdetails.ErrorInfo:
+					errorInfo = d
+				case *errdetails.BadRequest:
+					violations = d.GetFieldViolations()
+				}
+			}
+
+			require.NotNil(t, errorInfo)
+			require.Equal(t, tc.ExpectedReason.String(), errorInfo.GetReason())
+			require.Equal(t, errcodes.Domain, errorInfo.GetDomain())
+			require.Equal(t, "trace", errorInfo.GetMetadata()["trace_id"])
+
+			require.Len(t, violations, len(tc.ExpectedViolations))
+			for i, v := range tc.ExpectedViolations {
+				require.Equal(t, v.GetField(), violations[i].GetField())
+				require.Equal(t, v.GetDescription(), violations[i].GetDescription())
+			}
+		})
+	}
+}
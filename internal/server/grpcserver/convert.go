@@ -1,9 +1,12 @@
 package grpcserver
 
 import (
+	"fmt"
 	"schedule/internal/domain/aggregate"
 	"schedule/internal/domain/value"
+	"schedule/internal/util"
 	schedulev1 "schedule/pkg/grpc"
+	"time"
 )
 
 func newDomainScheduleWithDuration(req *schedulev1.CreateScheduleRequest) *aggregate.ScheduleWithDuration {
@@ -15,6 +18,36 @@ func newDomainScheduleWithDuration(req *schedulev1.CreateScheduleRequest) *aggre
 	}
 }
 
+func newDomainScheduleListFilter(req *schedulev1.ListSchedulesRequest) (*aggregate.ScheduleListFilter, error) {
+	status, ok := aggregate.ParseScheduleStatus(req.GetStatus())
+	if !ok {
+		return nil, fmt.Errorf("unknown status '%s'", req.GetStatus())
+	}
+	sort, ok := aggregate.ParseScheduleListSort(req.GetSort())
+	if !ok {
+		return nil, fmt.Errorf("unknown sort '%s'", req.GetSort())
+	}
+
+	filter := &aggregate.ScheduleListFilter{
+		UserId:       value.UserId(req.GetUserId()),
+		NameContains: req.GetNameContains(),
+		Status:       status,
+		Sort:         sort,
+		Desc:         req.GetDesc(),
+		Cursor:       req.GetPageToken(),
+		Limit:        int(req.GetPageSize()),
+	}
+
+	if req.GetEndAtFrom() != 0 {
+		filter.EndAtFrom = util.Ptr(unixToDate(req.GetEndAtFrom()))
+	}
+	if req.GetEndAtTo() != 0 {
+		filter.EndAtTo = util.Ptr(unixToDate(req.GetEndAtTo()))
+	}
+
+	return filter, nil
+}
+
 func newGRPCCreateScheduleReply(scheduleId value.ScheduleId) *schedulev1.CreateScheduleReply {
 	return &schedulev1.CreateScheduleReply{
 		Id: int32(scheduleId),
@@ -50,6 +83,26 @@ func newGRPCGetSchedulesReply(ids []value.ScheduleId) *schedulev1.GetSchedulesRe
 	}
 }
 
+func newGRPCListSchedulesReply(list *aggregate.ScheduleList) *schedulev1.ListSchedulesReply {
+	grpcItems := make([]*schedulev1.ListSchedulesReplyItem, len(list.Schedules))
+
+	for i, schedule := range list.Schedules {
+		grpcItems[i] = &schedulev1.ListSchedulesReplyItem{
+			Id:     int32(schedule.Id),
+			Name:   schedule.Name.String(),
+			Period: int64(schedule.Period),
+		}
+		if !schedule.EndAt.IsNil() {
+			grpcItems[i].EndAt = schedule.EndAt.Unix()
+		}
+	}
+
+	return &schedulev1.ListSchedulesReply{
+		Items:         grpcItems,
+		NextPageToken: list.NextCursor,
+	}
+}
+
 func newGRPCGetNextTakingsReply(schedules []aggregate.ScheduleNextTaking) *schedulev1.GetNextTakingsReply {
 	grpcRespItems := make([]*schedulev1.GetNextTakingsReplyItem, len(schedules))
 
@@ -68,3 +121,15 @@ func newGRPCGetNextTakingsReply(schedules []aggregate.ScheduleNextTaking) *sched
 		Items: grpcRespItems,
 	}
 }
+
+// Shared between api versions.
+
+func unixToDate(sec int64) time.Time {
+	return timeToDate(time.Unix(sec, 0))
+}
+
+// timeToDate truncates time to date in UTC, end dates stored without time.
+func timeToDate(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
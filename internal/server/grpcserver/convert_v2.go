@@ -0,0 +1,319 @@
+package grpcserver
+
+import (
+	"errors"
+	"fmt"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"schedule/internal/domain/aggregate"
+	"schedule/internal/domain/entity"
+	"schedule/internal/domain/value"
+	"schedule/internal/util"
+	"schedule/pkg/failure"
+	schedulev2 "schedule/pkg/grpc/v2"
+	"time"
+)
+
+var (
+	v2ScheduleStatuses = map[schedulev2.ScheduleStatus]aggregate.ScheduleStatus{
+		schedulev2.ScheduleStatus_SCHEDULE_STATUS_UNSPECIFIED: aggregate.ScheduleStatusAny,
+		schedulev2.ScheduleStatus_SCHEDULE_STATUS_ACTIVE:      aggregate.ScheduleStatusActive,
+		schedulev2.ScheduleStatus_SCHEDULE_STATUS_EXPIRED:     aggregate.ScheduleStatusExpired,
+	}
+	v2ScheduleSortFields = map[schedulev2.ScheduleSortField]aggregate.ScheduleListSort{
+		schedulev2.ScheduleSortField_SCHEDULE_SORT_FIELD_UNSPECIFIED: aggregate.ScheduleListSortId,
+		schedulev2.ScheduleSortField_SCHEDULE_SORT_FIELD_ID:          aggregate.ScheduleListSortId,
+		schedulev2.ScheduleSortField_SCHEDULE_SORT_FIELD_NAME:        aggregate.ScheduleListSortName,
+		schedulev2.ScheduleSortField_SCHEDULE_SORT_FIELD_END_TIME:    aggregate.ScheduleListSortEndAt,
+	}
+	v2SlotRejectReasons = map[aggregate.ScheduleSlotReason]schedulev2.SlotRejectReason{
+		aggregate.ScheduleSlotReasonNight:         schedulev2.SlotRejectReason_SLOT_REJECT_REASON_NIGHT,
+		aggregate.ScheduleSlotReasonExpired:       schedulev2.SlotRejectReason_SLOT_REJECT_REASON_EXPIRED,
+		aggregate.ScheduleSlotReasonOutsidePeriod: schedulev2.SlotRejectReason_SLOT_REJECT_REASON_OUTSIDE_PERIOD,
+		aggregate.ScheduleSlotReasonRounded:       schedulev2.SlotRejectReason_SLOT_REJECT_REASON_ROUNDED,
+		aggregate.ScheduleSlotReasonPassed:        schedulev2.SlotRejectReason_SLOT_REJECT_REASON_PASSED,
+		aggregate.ScheduleSlotReasonBeforeAnchor:  schedulev2.SlotRejectReason_SLOT_REJECT_REASON_BEFORE_ANCHOR,
+	}
+	v2ScheduleWarnings = map[aggregate.ScheduleWarning]schedulev2.ScheduleWarning{
+		aggregate.ScheduleWarningShortInterval: schedulev2.ScheduleWarning_SCHEDULE_WARNING_SHORT_INTERVAL,
+	}
+)
+
+func newDomainScheduleWithDurationV2(req *schedulev2.CreateScheduleRequest) (*aggregate.ScheduleWithDuration, error) {
+	schedule := &aggregate.ScheduleWithDuration{
+		UserId:        value.UserId(req.GetUserId()),
+		Name:          value.ScheduleName(req.GetName()),
+		Duration:      value.ScheduleDuration(req.GetDurationDays()),
+		RoundTheClock: req.GetRoundTheClock(),
+		Reanchor:      req.GetReanchor(),
+		TimesPerDay:   int(req.GetTimesPerDay()),
+		Doses:         int(req.GetDoses()),
+	}
+
+	if req.GetPeriod() != nil || req.GetTimesPerDay() == 0 {
+		if err := req.GetPeriod().CheckValid(); err != nil {
+			return nil, fmt.Errorf("invalid period: %w", err)
+		}
+		schedule.Period = value.SchedulePeriod(req.GetPeriod().AsDuration())
+	}
+
+	if req.GetAnchorTime() != nil {
+		if err := req.GetAnchorTime().CheckValid(); err != nil {
+			return nil, failure.NewValidationError(failure.Violation{Field: "anchor_time", Description: err.Error()})
+		}
+		schedule.AnchorAt = util.Ptr(req.GetAnchorTime().AsTime())
+	}
+
+	if req.GetConsolidationTolerance() != nil {
+		if err := req.GetConsolidationTolerance().CheckValid(); err != nil {
+			return nil, failure.NewValidationError(failure.Violation{Field: "consolidation_tolerance", Description: err.Error()})
+		}
+		schedule.ConsolidationTolerance = req.GetConsolidationTolerance().AsDuration()
+	}
+
+	return schedule, nil
+}
+
+func newDomainScheduleListFilterV2(req *schedulev2.ListSchedulesRequest) (*aggregate.ScheduleListFilter, error) {
+	status, ok := v2ScheduleStatuses[req.GetStatus()]
+	if !ok {
+		return nil, fmt.Errorf("unknown status '%s'", req.GetStatus())
+	}
+	sort, ok := v2ScheduleSortFields[req.GetSortField()]
+	if !ok {
+		return nil, fmt.Errorf("unknown sort field '%s'", req.GetSortField())
+	}
+
+	filter := &aggregate.ScheduleListFilter{
+		UserId:       value.UserId(req.GetUserId()),
+		NameContains: req.GetNameContains(),
+		Status:       status,
+		Sort:         sort,
+		Desc:         req.GetDescending(),
+		Cursor:       req.GetPageToken(),
+		Limit:        int(req.GetPageSize()),
+	}
+
+	if req.GetEndTimeFrom() != nil {
+		if err := req.GetEndTimeFrom().CheckValid(); err != nil {
+			return nil, fmt.Errorf("invalid end_time_from: %w", err)
+		}
+		filter.EndAtFrom = util.Ptr(timeToDate(req.GetEndTimeFrom().AsTime()))
+	}
+	if req.GetEndTimeTo() != nil {
+		if err := req.GetEndTimeTo().CheckValid(); err != nil {
+			return nil, fmt.Errorf("invalid end_time_to: %w", err)
+		}
+		filter.EndAtTo = util.Ptr(timeToDate(req.GetEndTimeTo().AsTime()))
+	}
+
+	if filter.EndAtFrom != nil && filter.EndAtTo != nil && filter.EndAtTo.Before(*filter.EndAtFrom) {
+		return nil, errors.New("end_time_to is before end_time_from")
+	}
+
+	return filter, nil
+}
+
+func newGRPCScheduleV2(schedule *entity.Schedule) *schedulev2.Schedule {
+	return &schedulev2.Schedule{
+		Id:                     int32(schedule.Id),
+		Name:                   schedule.Name.String(),
+		Period:                 durationpb.New(schedule.Period.Duration()),
+		EndTime:                newGRPCEndTimeV2(schedule.EndAt),
+		RoundTheClock:          schedule.RoundTheClock,
+		AnchorTime:             newGRPCOptionalTimeV2(schedule.AnchorAt),
+		Reanchor:               schedule.Reanchor,
+		TimesPerDay:            uint32(len(schedule.DaySlots)),
+		FinalDoseTime:          newGRPCOptionalTimeV2(schedule.FinalDoseAt),
+		ConsolidationTolerance: newGRPCOptionalDurationV2(schedule.ConsolidationTolerance),
+	}
+}
+
+func newGRPCScheduleWithTimetableV2(timetable *aggregate.ScheduleWithTimetable) *schedulev2.Schedule {
+	return &schedulev2.Schedule{
+		Id:                     int32(timetable.Id),
+		Name:                   timetable.Name.String(),
+		Period:                 durationpb.New(timetable.Period.Duration()),
+		EndTime:                newGRPCEndTimeV2(timetable.EndAt),
+		Timetable:              newGRPCTimetableV2(timetable.Timetable),
+		RoundTheClock:          timetable.RoundTheClock,
+		NightSlots:             newGRPCTimetableV2(timetable.Timetable.Night()),
+		AnchorTime:             newGRPCOptionalTimeV2(timetable.AnchorAt),
+		Reanchor:               timetable.Reanchor,
+		TimesPerDay:            uint32(timetable.TimesPerDay),
+		Warnings:               newGRPCScheduleWarningsV2(timetable.Warnings),
+		FinalDoseTime:          newGRPCOptionalTimeV2(timetable.FinalDoseAt),
+		RemainingDoses:         newGRPCRemainingDosesV2(timetable.RemainingDoses),
+		ConsolidationTolerance: newGRPCOptionalDurationV2(timetable.ConsolidationTolerance),
+	}
+}
+
+func newGRPCScheduleWarningsV2(warnings []aggregate.ScheduleWarning) []schedulev2.ScheduleWarning {
+	grpcWarnings := make([]schedulev2.ScheduleWarning, len(warnings))
+	for i, warning := range warnings {
+		grpcWarnings[i] = v2ScheduleWarnings[warning]
+	}
+	return grpcWarnings
+}
+
+func newGRPCRemainingDosesV2(remainingDoses *int) *uint32 {
+	if remainingDoses == nil {
+		return nil
+	}
+	return util.Ptr(uint32(*remainingDoses))
+}
+
+// newGRPCOptionalTimeV2 returns nil for nil time, so field is not set.
+func newGRPCOptionalTimeV2(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+func newGRPCOptionalDurationV2(d *time.Duration) *durationpb.Duration {
+	if d == nil {
+		return nil
+	}
+	return durationpb.New(*d)
+}
+
+func newGRPCTimetableV2(timetable value.ScheduleTimeTable) []*timestamppb.Timestamp {
+	grpcTimetable := make([]*timestamppb.Timestamp, len(timetable))
+	for i, t := range timetable {
+		grpcTimetable[i] = timestamppb.New(t.Time)
+	}
+	return grpcTimetable
+}
+
+func newGRPCPreviewScheduleResponseV2(preview *aggregate.SchedulePreview) *schedulev2.PreviewScheduleResponse {
+	days := make([]*schedulev2.PreviewDay, len(preview.Days))
+	for i, day := range preview.Days {
+		days[i] = &schedulev2.PreviewDay{
+			Date:       timestamppb.New(day.Date),
+			Timetable:  newGRPCTimetableV2(day.Timetable),
+			NightSlots: newGRPCTimetableV2(day.Timetable.Night()),
+		}
+	}
+
+	nextTakings := make([]*timestamppb.Timestamp, len(preview.NextTakings))
+	for i, t := range preview.NextTakings {
+		nextTakings[i] = timestamppb.New(t.Time)
+	}
+
+	return &schedulev2.PreviewScheduleResponse{
+		Schedule: &schedulev2.Schedule{
+			Name:           preview.Name.String(),
+			Period:         durationpb.New(preview.Period.Duration()),
+			EndTime:        newGRPCEndTimeV2(preview.EndAt),
+			RoundTheClock:  preview.RoundTheClock,
+			TimesPerDay:    uint32(preview.TimesPerDay),
+			Warnings:       newGRPCScheduleWarningsV2(preview.Warnings),
+			FinalDoseTime:  newGRPCOptionalTimeV2(preview.FinalDoseAt),
+			RemainingDoses: newGRPCRemainingDosesV2(preview.RemainingDoses),
+		},
+		Days:        days,
+		NextTakings: nextTakings,
+	}
+}
+
+func newGRPCListSchedulesResponseV2(list *aggregate.ScheduleList) *schedulev2.ListSchedulesResponse {
+	schedules := make([]*schedulev2.Schedule, len(list.Schedules))
+	for i, schedule := range list.Schedules {
+		schedules[i] = newGRPCScheduleV2(schedule)
+	}
+
+	return &schedulev2.ListSchedulesResponse{
+		Schedules:     schedules,
+		NextPageToken: list.NextCursor,
+	}
+}
+
+func newGRPCListNextTakingsResponseV2(nextTakings []aggregate.ScheduleNextTaking) *schedulev2.ListNextTakingsResponse {
+	grpcNextTakings := make([]*schedulev2.NextTaking, len(nextTakings))
+
+	for i, item := range nextTakings {
+		grpcNextTakings[i] = &schedulev2.NextTaking{
+			Schedule: &schedulev2.Schedule{
+				Id:      int32(item.Id),
+				Name:    item.Name.String(),
+				Period:  durationpb.New(item.Period.Duration()),
+				EndTime: newGRPCEndTimeV2(item.EndAt),
+			},
+			Time:  timestamppb.New(item.NextTaking.Time),
+			Night: item.Night,
+		}
+	}
+
+	return &schedulev2.ListNextTakingsResponse{
+		NextTakings: grpcNextTakings,
+	}
+}
+
+func newGRPCSlotDecisionsV2(decisions []aggregate.ScheduleSlotDecision) []*schedulev2.SlotDecision {
+	grpcDecisions := make([]*schedulev2.SlotDecision, len(decisions))
+
+	for i, decision := range decisions {
+		grpcDecisions[i] = &schedulev2.SlotDecision{
+			ScheduleId: int32(decision.ScheduleId),
+			Time:       timestamppb.New(decision.Time),
+			Kept:       decision.Kept,
+			Reason:     v2SlotRejectReasons[decision.Reason],
+		}
+	}
+
+	return grpcDecisions
+}
+
+func newGRPCDayPlanV2(plan *aggregate.DayPlan) *schedulev2.DayPlan {
+	slots := make([]*schedulev2.DayPlanSlot, len(plan.Slots))
+
+	for i, slot := range plan.Slots {
+		doses := make([]*schedulev2.DayPlanDose, len(slot.Doses))
+		for j, dose := range slot.Doses {
+			doses[j] = &schedulev2.DayPlanDose{
+				ScheduleId: int32(dose.ScheduleId),
+				Name:       dose.Name.String(),
+				Late:       dose.Late,
+			}
+			if !dose.PrescribedTime.Equal(slot.Time.Time) {
+				doses[j].PrescribedTime = timestamppb.New(dose.PrescribedTime.Time)
+			}
+			if dose.TakenAt != nil {
+				doses[j].TakenAt = timestamppb.New(*dose.TakenAt)
+			}
+		}
+		slots[i] = &schedulev2.DayPlanSlot{
+			Time:  timestamppb.New(slot.Time.Time),
+			Doses: doses,
+			Night: slot.Time.Night,
+		}
+	}
+
+	return &schedulev2.DayPlan{
+		Date:         timestamppb.New(plan.Date),
+		Slots:        slots,
+		Consolidated: plan.Consolidated,
+	}
+}
+
+func newDomainUserSettingsV2(req *schedulev2.UserSettings) *entity.UserSettings {
+	return &entity.UserSettings{
+		UserId:               value.UserId(req.GetUserId()),
+		ConsolidateReminders: req.GetConsolidateReminders(),
+	}
+}
+
+func newGRPCUserSettingsV2(settings *entity.UserSettings) *schedulev2.UserSettings {
+	return &schedulev2.UserSettings{
+		UserId:               int64(settings.UserId),
+		ConsolidateReminders: settings.ConsolidateReminders,
+	}
+}
+
+func newGRPCEndTimeV2(endAt value.ScheduleEndAt) *timestamppb.Timestamp {
+	if endAt.IsNil() {
+		return nil
+	}
+	return timestamppb.New(endAt.ToTime())
+}
@@ -0,0 +1,197 @@
+package grpcserver
+
+import (
+	"context"
+	"schedule/internal/domain/value"
+	"schedule/internal/server"
+	"schedule/pkg/failure"
+	schedulev2 "schedule/pkg/grpc/v2"
+	"time"
+)
+
+type scheduleAPIV2 struct {
+	schedulev2.UnimplementedScheduleServiceServer
+	schedule server.ScheduleUsecase
+}
+
+func (s *scheduleAPIV2) CreateSchedule(ctx context.Context, req *schedulev2.CreateScheduleRequest) (*schedulev2.Schedule, error) {
+	schedule, err := newDomainScheduleWithDurationV2(req)
+	if err != nil {
+		return nil, newStatusError(ctx, err)
+	}
+
+	if err := schedule.Validate(s.schedule.Now(ctx)); err != nil {
+		return nil, newStatusError(ctx, err)
+	}
+
+	id, _, err := s.schedule.Create(ctx, schedule) // warnings are in timetable
+	if err != nil {
+		return nil, handleError(ctx, err)
+	}
+
+	timetable, err := s.schedule.GetTimetable(ctx, schedule.UserId, id)
+	if err != nil {
+		return nil, handleError(ctx, err)
+	}
+
+	return newGRPCScheduleWithTimetableV2(timetable), nil
+}
+
+func (s *scheduleAPIV2) PreviewSchedule(ctx context.Context, req *schedulev2.PreviewScheduleRequest) (*schedulev2.PreviewScheduleResponse, error) {
+	schedule, err := newDomainScheduleWithDurationV2(req.GetSchedule())
+	if err != nil {
+		return nil, newStatusError(ctx, err)
+	}
+
+	preview, err := s.schedule.Preview(ctx, schedule, int(req.GetDays()))
+	if err != nil {
+		return nil, handleError(ctx, err)
+	}
+
+	return newGRPCPreviewScheduleResponseV2(preview), nil
+}
+
+func (s *scheduleAPIV2) GetSchedule(ctx context.Context, req *schedulev2.GetScheduleRequest) (*schedulev2.Schedule, error) {
+	if req.GetUserId() == 0 {
+		return nil, newRequiredFieldError(ctx, "user_id", "user id is required")
+	}
+	if req.GetScheduleId() == 0 {
+		return nil, newRequiredFieldError(ctx, "schedule_id", "schedule id is required")
+	}
+
+	if req.GetExplain() {
+		timetable, decisions, err := s.schedule.ExplainTimetable(ctx, value.UserId(req.GetUserId()), value.ScheduleId(req.GetScheduleId()))
+		if err != nil {
+			return nil, handleError(ctx, err)
+		}
+
+		resp := newGRPCScheduleWithTimetableV2(timetable)
+		resp.Explanation = newGRPCSlotDecisionsV2(decisions)
+		return resp, nil
+	}
+
+	timetable, err := s.schedule.GetTimetable(ctx, value.UserId(req.GetUserId()), value.ScheduleId(req.GetScheduleId()))
+	if err != nil {
+		return nil, handleError(ctx, err)
+	}
+
+	return newGRPCScheduleWithTimetableV2(timetable), nil
+}
+
+func (s *scheduleAPIV2) RecordIntake(ctx context.Context, req *schedulev2.RecordIntakeRequest) (*schedulev2.Schedule, error) {
+	if req.GetUserId() == 0 {
+		return nil, newRequiredFieldError(ctx, "user_id", "user id is required")
+	}
+	if req.GetScheduleId() == 0 {
+		return nil, newRequiredFieldError(ctx, "schedule_id", "schedule id is required")
+	}
+
+	var takenAt time.Time
+	if req.GetTakenTime() != nil {
+		if err := req.GetTakenTime().CheckValid(); err != nil {
+			return nil, newStatusError(ctx, failure.NewValidationError(failure.Violation{Field: "taken_time", Description: err.Error()}))
+		}
+		takenAt = req.GetTakenTime().AsTime()
+	}
+
+	timetable, err := s.schedule.RecordIntake(ctx, value.UserId(req.GetUserId()), value.ScheduleId(req.GetScheduleId()), takenAt)
+	if err != nil {
+		return nil, handleError(ctx, err)
+	}
+
+	return newGRPCScheduleWithTimetableV2(timetable), nil
+}
+
+func (s *scheduleAPIV2) ListSchedules(ctx context.Context, req *schedulev2.ListSchedulesRequest) (*schedulev2.ListSchedulesResponse, error) {
+	if req.GetUserId() == 0 {
+		return nil, newRequiredFieldError(ctx, "user_id", "user id is required")
+	}
+
+	filter, err := newDomainScheduleListFilterV2(req)
+	if err != nil {
+		return nil, newStatusError(ctx, err)
+	}
+
+	list, err := s.schedule.List(ctx, filter)
+	if err != nil {
+		return nil, handleError(ctx, err)
+	}
+
+	return newGRPCListSchedulesResponseV2(list), nil
+}
+
+func (s *scheduleAPIV2) ListNextTakings(ctx context.Context, req *schedulev2.ListNextTakingsRequest) (*schedulev2.ListNextTakingsResponse, error) {
+	if req.GetUserId() == 0 {
+		return nil, newRequiredFieldError(ctx, "user_id", "user id is required")
+	}
+
+	if req.GetExplain() {
+		nextTakings, decisions, err := s.schedule.ExplainNextTakings(ctx, value.UserId(req.GetUserId()))
+		if err != nil {
+			return nil, handleError(ctx, err)
+		}
+
+		resp := newGRPCListNextTakingsResponseV2(nextTakings)
+		resp.Explanation = newGRPCSlotDecisionsV2(decisions)
+		return resp, nil
+	}
+
+	nextTakings, err := s.schedule.GetNextTakings(ctx, value.UserId(req.GetUserId()))
+	if err != nil {
+		return nil, handleError(ctx, err)
+	}
+
+	return newGRPCListNextTakingsResponseV2(nextTakings), nil
+}
+
+func (s *scheduleAPIV2) GetDayPlan(ctx context.Context, req *schedulev2.GetDayPlanRequest) (*schedulev2.DayPlan, error) {
+	if req.GetUserId() == 0 {
+		return nil, newRequiredFieldError(ctx, "user_id", "user id is required")
+	}
+
+	var date time.Time
+	if req.GetDate() != "" {
+		var err error
+		if date, err = time.Parse(time.DateOnly, req.GetDate()); err != nil {
+			return nil, newRequiredFieldError(ctx, "date", err.Error())
+		}
+	}
+
+	getDayPlan := s.schedule.GetDayPlan
+	if req.GetConsolidate() {
+		getDayPlan = s.schedule.PreviewConsolidatedDayPlan
+	}
+
+	plan, err := getDayPlan(ctx, value.UserId(req.GetUserId()), date)
+	if err != nil {
+		return nil, handleError(ctx, err)
+	}
+
+	return newGRPCDayPlanV2(plan), nil
+}
+
+func (s *scheduleAPIV2) GetUserSettings(ctx context.Context, req *schedulev2.GetUserSettingsRequest) (*schedulev2.UserSettings, error) {
+	if req.GetUserId() == 0 {
+		return nil, newRequiredFieldError(ctx, "user_id", "user id is required")
+	}
+
+	settings, err := s.schedule.GetSettings(ctx, value.UserId(req.GetUserId()))
+	if err != nil {
+		return nil, handleError(ctx, err)
+	}
+
+	return newGRPCUserSettingsV2(settings), nil
+}
+
+func (s *scheduleAPIV2) UpdateUserSettings(ctx context.Context, req *schedulev2.UserSettings) (*schedulev2.UserSettings, error) {
+	if req.GetUserId() == 0 {
+		return nil, newRequiredFieldError(ctx, "user_id", "user id is required")
+	}
+
+	settings := newDomainUserSettingsV2(req)
+	if err := s.schedule.UpdateSettings(ctx, settings); err != nil {
+		return nil, handleError(ctx, err)
+	}
+
+	return newGRPCUserSettingsV2(settings), nil
+}
@@ -0,0 +1,111 @@
+// Package health reports liveness and readiness for HTTP probes and grpc.health.v1.
+//
+// In grpc.health.v1 empty service name is liveness, it is serving until shutdown.
+// Readiness is reported for each registered service name.
+package health
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"log/slog"
+	"schedule/pkg/contextx"
+	"sync/atomic"
+	"time"
+)
+
+const pingTimeout = 2 * time.Second
+
+var ErrShuttingDown = errors.New("shutting down")
+
+type Pinger interface {
+	PingContext(ctx context.Context) error
+}
+
+type Checker struct {
+	db           Pinger // nil if storage has no connection
+	services     []string
+	grpc         *health.Server
+	shuttingDown atomic.Bool
+}
+
+// NewChecker makes checker, services are grpc services which status is reported with overall status.
+func NewChecker(db Pinger, services ...string) *Checker {
+	return &Checker{
+		db:       db,
+		services: services,
+		grpc:     health.NewServer(),
+	}
+}
+
+// GRPCServer returns grpc.health.v1 implementation, its status is updated by Watch.
+func (c *Checker) GRPCServer() healthpb.HealthServer {
+	return c.grpc
+}
+
+// Ready returns error if service must not receive traffic.
+func (c *Checker) Ready(ctx context.Context) error {
+	if c.shuttingDown.Load() {
+		return ErrShuttingDown
+	}
+
+	if c.db != nil {
+		ctx, cancel := context.WithTimeout(ctx, pingTimeout)
+		defer cancel()
+
+		if err := c.db.PingContext(ctx); err != nil {
+			return fmt.Errorf("ping database: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Watch checks readiness with interval and sets grpc serving status until ctx is done.
+func (c *Checker) Watch(ctx context.Context, interval time.Duration) {
+	l := contextx.GetLoggerOrDefault(ctx)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var lastErr error
+	for first := true; ; first = false {
+		err := c.Ready(ctx)
+		if errors.Is(err, ErrShuttingDown) {
+			return
+		}
+
+		if first || (err == nil) != (lastErr == nil) { // status changed
+			c.setServingStatus(err == nil)
+			if err != nil {
+				l.LogAttrs(ctx, slog.LevelWarn, "service is not ready", slog.String("err", err.Error()))
+			}
+		}
+		lastErr = err
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Shutdown marks service as not ready, it is called before servers are stopped.
+func (c *Checker) Shutdown() {
+	c.shuttingDown.Store(true)
+	c.grpc.Shutdown()
+}
+
+func (c *Checker) setServingStatus(serving bool) {
+	status := healthpb.HealthCheckResponse_NOT_SERVING
+	if serving {
+		status = healthpb.HealthCheckResponse_SERVING
+	}
+
+	for _, service := range c.services {
+		c.grpc.SetServingStatus(service, status)
+	}
+}
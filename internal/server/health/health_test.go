@@ -0,0 +1,61 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/require"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type pingerFunc func(ctx context.Context) error
+
+func (f pingerFunc) PingContext(ctx context.Context) error {
+	return f(ctx)
+}
+
+func TestChecker(t *testing.T) {
+	const service = "schedule.v1.Schedule"
+
+	ctx := context.Background()
+
+	var dbErr atomic.Pointer[error]
+	checker := NewChecker(pingerFunc(func(context.Context) error {
+		if err := dbErr.Load(); err != nil {
+			return *err
+		}
+		return nil
+	}), service)
+
+	watchCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go checker.Watch(watchCtx, 10*time.Millisecond)
+
+	requireStatus := func(service string, expected healthpb.HealthCheckResponse_ServingStatus) {
+		t.Helper()
+		require.Eventually(t, func() bool {
+			resp, err := checker.GRPCServer().Check(ctx, &healthpb.HealthCheckRequest{Service: service})
+			return err == nil && resp.GetStatus() == expected
+		}, time.Second, 10*time.Millisecond)
+	}
+
+	require.NoError(t, checker.Ready(ctx))
+	requireStatus(service, healthpb.HealthCheckResponse_SERVING)
+	requireStatus("", healthpb.HealthCheckResponse_SERVING)
+
+	errRefused := errors.New("connection refused")
+	dbErr.Store(&errRefused)
+	require.ErrorIs(t, checker.Ready(ctx), errRefused)
+	requireStatus(service, healthpb.HealthCheckResponse_NOT_SERVING)
+	requireStatus("", healthpb.HealthCheckResponse_SERVING)
+
+	dbErr.Store(nil)
+	requireStatus(service, healthpb.HealthCheckResponse_SERVING)
+
+	checker.Shutdown()
+	require.ErrorIs(t, checker.Ready(ctx), ErrShuttingDown)
+	requireStatus(service, healthpb.HealthCheckResponse_NOT_SERVING)
+	requireStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+}
@@ -1,16 +1,23 @@
 package httpserver
 
 import (
+	"fmt"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"net/url"
 	"schedule/internal/domain/aggregate"
+	"schedule/internal/domain/entity"
 	"schedule/internal/domain/value"
+	"schedule/internal/util"
+	"schedule/pkg/failure"
 	"schedule/pkg/rest"
+	"strconv"
+	"time"
 )
 
 func newDomainScheduleWithDuration(req *rest.CreateScheduleRequest) (*aggregate.ScheduleWithDuration, error) {
 	period, err := value.ParseSchedulePeriod(req.Period)
 	if err != nil {
-		return nil, err
-
+		return nil, newFieldError("period", err)
 	}
 
 	return &aggregate.ScheduleWithDuration{
@@ -21,20 +28,239 @@ func newDomainScheduleWithDuration(req *rest.CreateScheduleRequest) (*aggregate.
 	}, nil
 }
 
-func newRESTCreateScheduleResponse(id value.ScheduleId) rest.CreateScheduleResponse {
+func newDomainUserScheduleWithDuration(userId value.UserId, req *rest.CreateUserScheduleRequest) (*aggregate.ScheduleWithDuration, error) {
+	schedule := &aggregate.ScheduleWithDuration{
+		UserId:        userId,
+		Name:          value.ScheduleName(req.Name),
+		Duration:      value.ScheduleDuration(req.Duration),
+		RoundTheClock: req.RoundTheClock != nil && *req.RoundTheClock,
+		AnchorAt:      req.AnchorAt,
+		Reanchor:      req.Reanchor != nil && *req.Reanchor,
+	}
+
+	if req.TimesPerDay != nil {
+		schedule.TimesPerDay = *req.TimesPerDay
+	}
+	if req.Doses != nil {
+		schedule.Doses = *req.Doses
+	}
+	if req.Period != "" || req.TimesPerDay == nil {
+		period, err := value.ParseSchedulePeriod(req.Period)
+		if err != nil {
+			return nil, newFieldError("period", err)
+		}
+		schedule.Period = period
+	}
+	if req.ConsolidationTolerance != nil {
+		tolerance, err := time.ParseDuration(*req.ConsolidationTolerance)
+		if err != nil {
+			return nil, newFieldError("consolidation_tolerance", err)
+		}
+		schedule.ConsolidationTolerance = tolerance
+	}
+
+	return schedule, nil
+}
+
+func newDomainScheduleUpdate(req *rest.UpdateScheduleRequest) (*aggregate.ScheduleUpdate, error) {
+	update := &aggregate.ScheduleUpdate{}
+
+	if req.Name != nil {
+		update.Name = util.Ptr(value.ScheduleName(*req.Name))
+	}
+	if req.Duration != nil {
+		update.Duration = util.Ptr(value.ScheduleDuration(*req.Duration))
+	}
+	if req.Period != nil {
+		period, err := value.ParseSchedulePeriod(*req.Period)
+		if err != nil {
+			return nil, newFieldError("period", err)
+		}
+		update.Period = &period
+	}
+	if req.ConsolidationTolerance != nil {
+		tolerance, err := time.ParseDuration(*req.ConsolidationTolerance)
+		if err != nil {
+			return nil, newFieldError("consolidation_tolerance", err)
+		}
+		update.ConsolidationTolerance = &tolerance
+	}
+	update.TimesPerDay = req.TimesPerDay
+	update.Doses = req.Doses
+	update.RoundTheClock = req.RoundTheClock
+	update.AnchorAt = req.AnchorAt
+	update.Reanchor = req.Reanchor
+
+	return update, nil
+}
+
+func newDomainScheduleListFilter(form url.Values) (*aggregate.ScheduleListFilter, error) {
+	userId, err := value.ParseUserId(form.Get("user_id"))
+	if err != nil {
+		return nil, newFieldError("user_id", err)
+	}
+
+	filter := &aggregate.ScheduleListFilter{
+		UserId:       userId,
+		NameContains: form.Get("name"),
+		Cursor:       form.Get("cursor"),
+	}
+
+	var ok bool
+	if filter.Status, ok = aggregate.ParseScheduleStatus(form.Get("status")); !ok {
+		return nil, newFieldError("status", fmt.Errorf("unknown status '%s'", form.Get("status")))
+	}
+	if filter.Sort, ok = aggregate.ParseScheduleListSort(form.Get("sort")); !ok {
+		return nil, newFieldError("sort", fmt.Errorf("unknown sort '%s'", form.Get("sort")))
+	}
+
+	switch order := form.Get("order"); order {
+	case "", "asc":
+	case "desc":
+		filter.Desc = true
+	default:
+		return nil, newFieldError("order", fmt.Errorf("unknown order '%s'", order))
+	}
+
+	if s := form.Get("end_at_from"); s != "" {
+		t, err := time.Parse(time.DateOnly, s)
+		if err != nil {
+			return nil, newFieldError("end_at_from", err)
+		}
+		filter.EndAtFrom = &t
+	}
+	if s := form.Get("end_at_to"); s != "" {
+		t, err := time.Parse(time.DateOnly, s)
+		if err != nil {
+			return nil, newFieldError("end_at_to", err)
+		}
+		filter.EndAtTo = &t
+	}
+
+	if s := form.Get("limit"); s != "" {
+		if filter.Limit, err = strconv.Atoi(s); err != nil {
+			return nil, newFieldError("limit", err)
+		}
+	}
+
+	return filter, nil
+}
+
+func newFieldError(field string, err error) error {
+	return failure.NewValidationError(failure.Violation{Field: field, Description: err.Error()})
+}
+
+func newRESTCreateScheduleResponse(id value.ScheduleId, warnings []aggregate.ScheduleWarning) rest.CreateScheduleResponse {
 	return rest.CreateScheduleResponse{
-		Id: int(id),
+		Id:       int(id),
+		Warnings: newRESTScheduleWarnings(warnings),
 	}
 }
 
 func newRESTScheduleResponse(timetable *aggregate.ScheduleWithTimetable) *rest.ScheduleResponse {
 	return &rest.ScheduleResponse{
-		Id:        int(timetable.Id),
-		EndAt:     timetable.EndAt.NullableString(),
-		Name:      string(timetable.Name),
-		Period:    timetable.Period.String(),
-		Timetable: timetable.Timetable.ToStringArray(),
+		Id:                     int(timetable.Id),
+		EndAt:                  timetable.EndAt.NullableString(),
+		FinalDoseAt:            timetable.FinalDoseAt,
+		RemainingDoses:         timetable.RemainingDoses,
+		Name:                   string(timetable.Name),
+		Period:                 timetable.Period.String(),
+		TimesPerDay:            newRESTTimesPerDay(timetable.TimesPerDay),
+		Warnings:               newRESTScheduleWarnings(timetable.Warnings),
+		RoundTheClock:          timetable.RoundTheClock,
+		AnchorAt:               timetable.AnchorAt,
+		Reanchor:               timetable.Reanchor,
+		Timetable:              timetable.Timetable.ToStringArray(),
+		NightSlots:             newRESTNightSlots(timetable.Timetable),
+		ConsolidationTolerance: newRESTOptionalDuration(timetable.ConsolidationTolerance),
+	}
+}
+
+func newRESTOptionalDuration(d *time.Duration) *string {
+	if d == nil {
+		return nil
+	}
+	return util.Ptr(d.String())
+}
+
+// newRESTTimesPerDay returns nil for schedule stepped by period, so field is omitted.
+func newRESTTimesPerDay(timesPerDay int) *int {
+	if timesPerDay == 0 {
+		return nil
+	}
+	return &timesPerDay
+}
+
+// newRESTScheduleWarnings returns nil if there are no warnings, so field is omitted.
+func newRESTScheduleWarnings(warnings []aggregate.ScheduleWarning) *[]rest.ScheduleWarning {
+	if len(warnings) == 0 {
+		return nil
+	}
+	resp := make([]rest.ScheduleWarning, len(warnings))
+	for i, warning := range warnings {
+		resp[i] = rest.ScheduleWarning(warning)
 	}
+	return &resp
+}
+
+// newRESTNightSlots returns nil if timetable has no night items, so field is omitted.
+func newRESTNightSlots(timetable value.ScheduleTimeTable) *[]string {
+	night := timetable.Night()
+	if len(night) == 0 {
+		return nil
+	}
+	return util.Ptr(night.ToStringArray())
+}
+
+func newRESTSchedulePreviewResponse(preview *aggregate.SchedulePreview) *rest.SchedulePreviewResponse {
+	resp := &rest.SchedulePreviewResponse{
+		EndAt:          preview.EndAt.NullableString(),
+		FinalDoseAt:    preview.FinalDoseAt,
+		RemainingDoses: preview.RemainingDoses,
+		Name:           preview.Name.String(),
+		Period:         preview.Period.String(),
+		TimesPerDay:    newRESTTimesPerDay(preview.TimesPerDay),
+		Warnings:       newRESTScheduleWarnings(preview.Warnings),
+		RoundTheClock:  preview.RoundTheClock,
+		Days:           make([]rest.SchedulePreviewDay, len(preview.Days)),
+		NextTakings:    make([]string, len(preview.NextTakings)),
+	}
+
+	for i, day := range preview.Days {
+		resp.Days[i] = rest.SchedulePreviewDay{
+			Date:       openapi_types.Date{Time: day.Date},
+			Timetable:  day.Timetable.ToStringArray(),
+			NightSlots: newRESTNightSlots(day.Timetable),
+		}
+	}
+	for i, nextTaking := range preview.NextTakings {
+		resp.NextTakings[i] = nextTaking.String()
+	}
+
+	return resp
+}
+
+func newRESTSchedulesPageResponse(list *aggregate.ScheduleList) *rest.SchedulesPageResponse {
+	resp := &rest.SchedulesPageResponse{
+		Items: make([]rest.ScheduleItem, len(list.Schedules)),
+	}
+
+	for i, schedule := range list.Schedules {
+		resp.Items[i] = rest.ScheduleItem{
+			Id:            int(schedule.Id),
+			EndAt:         schedule.EndAt.NullableString(),
+			Name:          schedule.Name.String(),
+			Period:        schedule.Period.String(),
+			TimesPerDay:   newRESTTimesPerDay(len(schedule.DaySlots)),
+			RoundTheClock: schedule.RoundTheClock,
+		}
+	}
+
+	if list.NextCursor != "" {
+		resp.NextCursor = &list.NextCursor
+	}
+
+	return resp
 }
 
 func newRESTNextTakingResponse(schedules []aggregate.ScheduleNextTaking) []*rest.NextTakingResponse {
@@ -46,9 +272,156 @@ func newRESTNextTakingResponse(schedules []aggregate.ScheduleNextTaking) []*rest
 			EndAt:      t.EndAt.NullableString(),
 			Name:       string(t.Name),
 			NextTaking: t.NextTaking.String(),
+			Night:      t.Night,
 			Period:     t.Period.String(),
 		}
 	}
 
 	return resp
 }
+
+func newRESTScheduleExplainResponse(timetable *aggregate.ScheduleWithTimetable, decisions []aggregate.ScheduleSlotDecision) *rest.ScheduleResponse {
+	resp := newRESTScheduleResponse(timetable)
+	resp.Explanation = util.Ptr(newRESTSlotDecisions(decisions))
+	return resp
+}
+
+// newRESTNextTakingExplainResponse sets to each taking decisions about candidate takings of its schedule.
+func newRESTNextTakingExplainResponse(schedules []aggregate.ScheduleNextTaking, decisions []aggregate.ScheduleSlotDecision) []*rest.NextTakingResponse {
+	bySchedule := make(map[value.ScheduleId][]aggregate.ScheduleSlotDecision)
+	for _, decision := range decisions {
+		bySchedule[decision.ScheduleId] = append(bySchedule[decision.ScheduleId], decision)
+	}
+
+	resp := newRESTNextTakingResponse(schedules)
+	for i, t := range schedules {
+		resp[i].Explanation = util.Ptr(newRESTSlotDecisions(bySchedule[t.Id]))
+	}
+
+	return resp
+}
+
+func newRESTSlotDecisions(decisions []aggregate.ScheduleSlotDecision) []rest.SlotDecision {
+	resp := make([]rest.SlotDecision, len(decisions))
+
+	for i, decision := range decisions {
+		resp[i] = rest.SlotDecision{
+			ScheduleId: int(decision.ScheduleId),
+			Time:       decision.Time.Format(time.RFC3339),
+			Kept:       decision.Kept,
+		}
+		if decision.Reason != "" {
+			resp[i].Reason = util.Ptr(rest.SlotDecisionReason(decision.Reason))
+		}
+	}
+
+	return resp
+}
+
+func newRESTDayPlanResponse(plan *aggregate.DayPlan) *rest.DayPlanResponse {
+	resp := &rest.DayPlanResponse{
+		Date:         openapi_types.Date{Time: plan.Date},
+		Slots:        make([]rest.DayPlanSlot, len(plan.Slots)),
+		Consolidated: plan.Consolidated,
+	}
+
+	for i, slot := range plan.Slots {
+		resp.Slots[i] = rest.DayPlanSlot{
+			Time:  slot.Time.String(),
+			Night: slot.Time.Night,
+			Doses: make([]rest.DayPlanDose, len(slot.Doses)),
+		}
+		for j, dose := range slot.Doses {
+			resp.Slots[i].Doses[j] = rest.DayPlanDose{
+				ScheduleId: int(dose.ScheduleId),
+				Name:       dose.Name.String(),
+				TakenAt:    dose.TakenAt,
+				Late:       dose.Late,
+			}
+			if !dose.PrescribedTime.Equal(slot.Time.Time) {
+				resp.Slots[i].Doses[j].PrescribedTime = util.Ptr(dose.PrescribedTime.String())
+			}
+		}
+	}
+
+	return resp
+}
+
+func newDomainUserSettings(userId value.UserId, req *rest.UserSettings) *entity.UserSettings {
+	return &entity.UserSettings{
+		UserId:               userId,
+		ConsolidateReminders: req.ConsolidateReminders,
+	}
+}
+
+func newRESTUserSettings(settings *entity.UserSettings) *rest.UserSettings {
+	return &rest.UserSettings{
+		ConsolidateReminders: settings.ConsolidateReminders,
+	}
+}
+
+func newRESTAuditRecordsResponse(records []*entity.AuditRecord) []rest.AuditRecord {
+	resp := make([]rest.AuditRecord, len(records))
+
+	for i, record := range records {
+		resp[i] = rest.AuditRecord{
+			Id:        record.Id,
+			Action:    rest.AuditRecordAction(record.Action),
+			Actor:     record.Actor,
+			TraceId:   record.TraceId,
+			CreatedAt: record.CreatedAt,
+			Before:    newRESTScheduleSnapshot(record.Before),
+			After:     newRESTScheduleSnapshot(record.After),
+		}
+		if record.ClaimedActor != "" {
+			resp[i].ClaimedActor = util.Ptr(record.ClaimedActor)
+		}
+	}
+
+	return resp
+}
+
+func newRESTScheduleSnapshot(snapshot *entity.ScheduleSnapshot) *rest.ScheduleSnapshot {
+	if snapshot == nil {
+		return nil
+	}
+	resp := &rest.ScheduleSnapshot{
+		Name:   snapshot.Name.String(),
+		EndAt:  snapshot.EndAt.NullableString(),
+		Period: snapshot.Period.String(),
+	}
+	if snapshot.RoundTheClock {
+		resp.RoundTheClock = util.Ptr(true)
+	}
+	if snapshot.Reanchor {
+		resp.Reanchor = util.Ptr(true)
+	}
+	resp.AnchorAt = snapshot.AnchorAt
+	if snapshot.DaySlots != nil {
+		resp.DaySlots = util.Ptr(snapshot.DaySlots.ToStringArray())
+	}
+	resp.FinalDoseAt = snapshot.FinalDoseAt
+	resp.ConsolidationTolerance = newRESTOptionalDuration(snapshot.ConsolidationTolerance)
+	return resp
+}
+
+func newRESTImportSchedulesResponse(results []aggregate.ScheduleImportResult) *rest.ImportSchedulesResponse {
+	resp := &rest.ImportSchedulesResponse{
+		Rows: make([]rest.ImportScheduleRow, len(results)),
+	}
+
+	for i, result := range results {
+		resp.Rows[i].Line = result.Line
+
+		switch {
+		case result.Err != nil:
+			resp.Rows[i].Error = util.Ptr(failure.GetDetail(result.Err))
+			resp.Failed++
+		case result.Id != 0:
+			resp.Rows[i].Id = util.Ptr(int(result.Id))
+			resp.Created++
+		}
+	}
+
+	return resp
+}
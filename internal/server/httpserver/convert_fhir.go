@@ -0,0 +1,227 @@
+package httpserver
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"schedule/internal/domain/aggregate"
+	"schedule/internal/domain/entity"
+	"schedule/internal/domain/value"
+	"schedule/internal/util"
+	"schedule/pkg/fhir"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	fhirPatientReferencePrefix           = "Patient/"
+	fhirMedicationRequestReferencePrefix = fhir.ResourceTypeMedicationRequest + "/"
+)
+
+var fhirUnits = map[string]time.Duration{
+	fhir.UnitSecond: time.Second,
+	fhir.UnitMinute: time.Minute,
+	fhir.UnitHour:   time.Hour,
+	fhir.UnitDay:    24 * time.Hour,
+	fhir.UnitWeek:   7 * 24 * time.Hour,
+}
+
+// newDomainScheduleFromFHIR maps dosageInstruction.timing.repeat to period or day slots and duration.
+// Period is period * periodUnit / frequency, if period is not set timeOfDay are fixed times of takings.
+// Duration is counted in days from now to boundsPeriod.end or from boundsDuration.
+func newDomainScheduleFromFHIR(req *fhir.MedicationRequest, now time.Time) (*aggregate.ScheduleWithDuration, error) {
+	if req.ResourceType != fhir.ResourceTypeMedicationRequest {
+		return nil, fmt.Errorf("unexpected resource type '%s'", req.ResourceType)
+	}
+
+	userId, err := value.ParseUserId(strings.TrimPrefix(req.Subject.Reference, fhirPatientReferencePrefix))
+	if err != nil {
+		return nil, fmt.Errorf("invalid subject reference: %w", err)
+	}
+
+	schedule := &aggregate.ScheduleWithDuration{
+		UserId: userId,
+	}
+
+	if medication := req.MedicationCodeableConcept; medication != nil {
+		schedule.Name = value.ScheduleName(medication.Text)
+		if schedule.Name == "" && len(medication.Coding) > 0 {
+			schedule.Name = value.ScheduleName(medication.Coding[0].Display)
+		}
+	}
+
+	if len(req.DosageInstruction) == 0 || req.DosageInstruction[0].Timing == nil || req.DosageInstruction[0].Timing.Repeat == nil {
+		return nil, errors.New("dosageInstruction.timing.repeat is required")
+	}
+	repeat := req.DosageInstruction[0].Timing.Repeat
+
+	if repeat.Period != nil {
+		if schedule.Period, err = newDomainPeriodFromFHIR(repeat); err != nil {
+			return nil, err
+		}
+	} else if schedule.DaySlots, err = newDomainDaySlotsFromFHIR(repeat.TimeOfDay); err != nil {
+		return nil, err
+	}
+	if schedule.Duration, err = newDomainDurationFromFHIR(repeat, now); err != nil {
+		return nil, err
+	}
+
+	return schedule, nil
+}
+
+func newDomainPeriodFromFHIR(repeat *fhir.TimingRepeat) (value.SchedulePeriod, error) {
+	unit, ok := fhirUnits[repeat.PeriodUnit]
+	if !ok {
+		return 0, fmt.Errorf("unsupported period unit '%s'", repeat.PeriodUnit)
+	}
+
+	frequency := 1
+	if repeat.Frequency != nil {
+		frequency = *repeat.Frequency
+	}
+	if frequency <= 0 {
+		return 0, errors.New("frequency must be positive")
+	}
+
+	period := time.Duration(*repeat.Period*float64(unit)) / time.Duration(frequency)
+	return value.SchedulePeriod(period.Round(time.Second)), nil
+}
+
+// newDomainDaySlotsFromFHIR keeps times of day as they are, uneven times are not collapsed to period.
+func newDomainDaySlotsFromFHIR(timeOfDay []string) (value.ScheduleDaySlots, error) {
+	if len(timeOfDay) == 0 {
+		return nil, errors.New("period or timeOfDay is required")
+	}
+
+	slots := make(value.ScheduleDaySlots, len(timeOfDay))
+	for i, s := range timeOfDay {
+		t, err := time.Parse(time.TimeOnly, s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid timeOfDay: %w", err)
+		}
+		slots[i] = t.Sub(t.Truncate(24 * time.Hour))
+	}
+	slices.Sort(slots)
+
+	return slots, nil
+}
+
+func newDomainDurationFromFHIR(repeat *fhir.TimingRepeat, now time.Time) (value.ScheduleDuration, error) {
+	if repeat.Count != nil {
+		return 0, errors.New("count is not supported")
+	}
+
+	switch {
+	case repeat.BoundsPeriod != nil && repeat.BoundsPeriod.End != "":
+		end, err := parseFHIRDateTime(repeat.BoundsPeriod.End, now.Location())
+		if err != nil {
+			return 0, fmt.Errorf("invalid boundsPeriod.end: %w", err)
+		}
+
+		today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+		end = end.In(now.Location())
+		endDay := time.Date(end.Year(), end.Month(), end.Day(), 0, 0, 0, 0, now.Location())
+
+		days := int(math.Round(endDay.Sub(today).Hours() / 24))
+		if days <= 0 {
+			return 0, errors.New("boundsPeriod.end is in the past")
+		}
+		return value.ScheduleDuration(days), nil
+
+	case repeat.BoundsDuration != nil:
+		unit, ok := fhirUnits[repeat.BoundsDuration.Code]
+		if !ok {
+			unit, ok = fhirUnits[repeat.BoundsDuration.Unit]
+		}
+		if !ok || unit < 24*time.Hour {
+			return 0, fmt.Errorf("unsupported boundsDuration unit '%s'", repeat.BoundsDuration.Unit)
+		}
+
+		days := math.Ceil(repeat.BoundsDuration.Value * unit.Hours() / 24)
+		if days <= 0 {
+			return 0, errors.New("boundsDuration must be positive")
+		}
+		return value.ScheduleDuration(days), nil
+	}
+
+	return 0, nil
+}
+
+// parseFHIRDateTime parses date (YYYY-MM-DD) or dateTime with timezone.
+func parseFHIRDateTime(s string, loc *time.Location) (time.Time, error) {
+	if t, err := time.ParseInLocation(time.DateOnly, s, loc); err == nil {
+		return t, nil
+	}
+	return time.Parse(time.RFC3339, s)
+}
+
+func newFHIRMedicationRequest(userId value.UserId, schedule *aggregate.ScheduleWithTimetable, now time.Time) *fhir.MedicationRequest {
+	repeat := new(fhir.TimingRepeat)
+
+	// fixed times are exported without period, so import keeps them
+	if len(schedule.DaySlots) > 0 {
+		repeat.TimeOfDay = schedule.DaySlots.ToStringArray()
+	} else {
+		repeat.Frequency = util.Ptr(1)
+		repeat.TimeOfDay = schedule.Timetable.ToStringArray()
+
+		period := time.Duration(schedule.Period)
+		if period%time.Hour == 0 {
+			repeat.Period = util.Ptr(period.Hours())
+			repeat.PeriodUnit = fhir.UnitHour
+		} else {
+			repeat.Period = util.Ptr(period.Minutes())
+			repeat.PeriodUnit = fhir.UnitMinute
+		}
+	}
+
+	status := fhir.MedicationRequestStatusActive
+	if !schedule.EndAt.IsNil() {
+		repeat.BoundsPeriod = &fhir.Period{
+			End: schedule.EndAt.Format(time.DateOnly),
+		}
+		if schedule.EndAt.Before(now) {
+			status = fhir.MedicationRequestStatusCompleted
+		}
+	}
+
+	return &fhir.MedicationRequest{
+		ResourceType: fhir.ResourceTypeMedicationRequest,
+		Id:           strconv.Itoa(int(schedule.Id)),
+		Status:       status,
+		Intent:       fhir.MedicationRequestIntentOrder,
+		MedicationCodeableConcept: &fhir.CodeableConcept{
+			Text: schedule.Name.String(),
+		},
+		Subject: fhir.Reference{
+			Reference: fhirPatientReferencePrefix + strconv.FormatInt(int64(userId), 10),
+		},
+		DosageInstruction: []fhir.Dosage{
+			{
+				Timing: &fhir.Timing{
+					Repeat: repeat,
+				},
+			},
+		},
+	}
+}
+
+func newFHIRMedicationAdministration(intake *entity.Intake) *fhir.MedicationAdministration {
+	return &fhir.MedicationAdministration{
+		ResourceType: fhir.ResourceTypeMedicationAdministration,
+		Id:           strconv.FormatInt(intake.Id, 10),
+		Status:       fhir.MedicationAdministrationStatusCompleted,
+		MedicationCodeableConcept: &fhir.CodeableConcept{
+			Text: intake.Name.String(),
+		},
+		Subject: fhir.Reference{
+			Reference: fhirPatientReferencePrefix + strconv.FormatInt(int64(intake.UserId), 10),
+		},
+		EffectiveDateTime: intake.TakenAt.Format(time.RFC3339),
+		Request: &fhir.Reference{
+			Reference: fhirMedicationRequestReferencePrefix + strconv.Itoa(int(intake.ScheduleId)),
+		},
+	}
+}
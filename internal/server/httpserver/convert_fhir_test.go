@@ -0,0 +1,163 @@
+package httpserver
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/require"
+	"os"
+	"schedule/internal/domain/aggregate"
+	"schedule/internal/domain/entity"
+	"schedule/internal/domain/value"
+	"schedule/internal/util"
+	"schedule/pkg/fhir"
+	"testing"
+	"time"
+)
+
+func TestFHIRMedicationRequestRoundTrip(t *testing.T) {
+	now := time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		File     string
+		Expected []aggregate.ScheduleWithDuration
+	}{
+		{
+			File: "testdata/fhir/bundle_frequency.json",
+			Expected: []aggregate.ScheduleWithDuration{
+				{
+					UserId:   1000000000000000,
+					Name:     "Amoxicillin 250 MG Oral Capsule",
+					Duration: 10,
+					Period:   value.SchedulePeriod(time.Hour * 8),
+				},
+				{
+					UserId:   1000000000000000,
+					Name:     "Paracetamol 500 mg",
+					Duration: 0,
+					Period:   value.SchedulePeriod(time.Minute * 90),
+				},
+			},
+		},
+		{
+			File: "testdata/fhir/bundle_time_of_day.json",
+			Expected: []aggregate.ScheduleWithDuration{
+				{
+					UserId:   1000000000000001,
+					Name:     "Metformin 500 mg",
+					Duration: 14,
+					DaySlots: value.ScheduleDaySlots{time.Hour * 8, time.Hour * 20},
+				},
+				{
+					UserId:   1000000000000001,
+					Name:     "Atorvastatin 20 mg",
+					Duration: 31,
+					DaySlots: value.ScheduleDaySlots{time.Hour * 21},
+				},
+				{
+					UserId:   1000000000000001,
+					Name:     "Levetiracetam 500 mg",
+					DaySlots: value.ScheduleDaySlots{time.Hour * 8, time.Hour * 13, time.Hour * 20},
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		p, err := os.ReadFile(testCase.File)
+		require.NoError(t, err)
+
+		bundle := new(fhir.Bundle)
+		require.NoError(t, json.Unmarshal(p, bundle))
+		require.Len(t, bundle.Entry, len(testCase.Expected), testCase.File)
+
+		for i, entry := range bundle.Entry {
+			req := new(fhir.MedicationRequest)
+			require.NoError(t, json.Unmarshal(entry.Resource, req))
+
+			schedule, err := newDomainScheduleFromFHIR(req, now)
+			require.NoErrorf(t, err, "%s: entry %d", testCase.File, i)
+			require.Equalf(t, testCase.Expected[i], *schedule, "%s: entry %d", testCase.File, i)
+			require.NoErrorf(t, schedule.Validate(now), "%s: entry %d", testCase.File, i)
+
+			// export as stored schedule and import it again
+			var endAt *time.Time
+			if schedule.Duration > 0 {
+				endAt = util.Ptr(time.Date(now.Year(), now.Month(), now.Day()+int(schedule.Duration), 22, 0, 0, 0, time.UTC))
+			}
+			exported := newFHIRMedicationRequest(schedule.UserId, &aggregate.ScheduleWithTimetable{
+				Id:          value.ScheduleId(i + 1),
+				Name:        schedule.Name,
+				EndAt:       value.NewScheduleEndAt(endAt),
+				Period:      schedule.Period,
+				TimesPerDay: len(schedule.DaySlots),
+				DaySlots:    schedule.DaySlots,
+			}, now)
+			if req.DosageInstruction[0].Timing.Repeat.Period == nil {
+				require.ElementsMatchf(t, req.DosageInstruction[0].Timing.Repeat.TimeOfDay, exported.DosageInstruction[0].Timing.Repeat.TimeOfDay, "%s: entry %d", testCase.File, i)
+			}
+
+			p, err := json.Marshal(exported)
+			require.NoError(t, err)
+
+			reimportedReq := new(fhir.MedicationRequest)
+			require.NoError(t, json.Unmarshal(p, reimportedReq))
+
+			reimported, err := newDomainScheduleFromFHIR(reimportedReq, now)
+			require.NoErrorf(t, err, "%s: entry %d", testCase.File, i)
+			require.Equalf(t, *schedule, *reimported, "%s: entry %d", testCase.File, i)
+		}
+	}
+}
+
+func TestFHIRMedicationRequestInvalid(t *testing.T) {
+	now := time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := []fhir.MedicationRequest{
+		{ // wrong subject
+			ResourceType: fhir.ResourceTypeMedicationRequest,
+			Subject:      fhir.Reference{Reference: "Practitioner/1"},
+		},
+		{ // no timing
+			ResourceType: fhir.ResourceTypeMedicationRequest,
+			Subject:      fhir.Reference{Reference: "Patient/1"},
+		},
+		{ // end in the past
+			ResourceType: fhir.ResourceTypeMedicationRequest,
+			Subject:      fhir.Reference{Reference: "Patient/1"},
+			DosageInstruction: []fhir.Dosage{{Timing: &fhir.Timing{Repeat: &fhir.TimingRepeat{
+				Period:       util.Ptr(1.0),
+				PeriodUnit:   fhir.UnitHour,
+				BoundsPeriod: &fhir.Period{End: "2024-12-31"},
+			}}}},
+		},
+		{ // unknown unit
+			ResourceType: fhir.ResourceTypeMedicationRequest,
+			Subject:      fhir.Reference{Reference: "Patient/1"},
+			DosageInstruction: []fhir.Dosage{{Timing: &fhir.Timing{Repeat: &fhir.TimingRepeat{
+				Period:     util.Ptr(1.0),
+				PeriodUnit: "mo",
+			}}}},
+		},
+	}
+
+	for i, testCase := range testCases {
+		_, err := newDomainScheduleFromFHIR(&testCase, now)
+		require.Errorf(t, err, "test case: %d", i+1)
+	}
+}
+
+func TestFHIRMedicationAdministration(t *testing.T) {
+	expected, err := os.ReadFile("testdata/fhir/medication_administration.json")
+	require.NoError(t, err)
+
+	exported := newFHIRMedicationAdministration(&entity.Intake{
+		Id:         7,
+		ScheduleId: 5,
+		UserId:     1000000000000000,
+		Name:       "Amoxicillin 250 MG Oral Capsule",
+		TakenAt:    time.Date(2025, time.January, 1, 11, 30, 0, 0, time.FixedZone("", 3*60*60)),
+	})
+
+	p, err := json.Marshal(exported)
+	require.NoError(t, err)
+	require.JSONEq(t, string(expected), string(p))
+}
@@ -0,0 +1,37 @@
+package httpserver
+
+import (
+	"context"
+	"github.com/gorilla/mux"
+	"net/http"
+)
+
+const (
+	HealthzPath = "/healthz"
+	ReadyzPath  = "/readyz"
+)
+
+type ReadinessChecker interface {
+	Ready(ctx context.Context) error
+}
+
+// RegisterHealthRoutes registers kubernetes liveness and readiness probes.
+func RegisterHealthRoutes(rtr *mux.Router, checker ReadinessChecker) {
+	rtr.HandleFunc(HealthzPath, func(w http.ResponseWriter, r *http.Request) {
+		writeProbe(w, http.StatusOK, "ok")
+	}).Methods(http.MethodGet)
+
+	rtr.HandleFunc(ReadyzPath, func(w http.ResponseWriter, r *http.Request) {
+		if err := checker.Ready(r.Context()); err != nil {
+			writeProbe(w, http.StatusServiceUnavailable, err.Error())
+			return
+		}
+		writeProbe(w, http.StatusOK, "ok")
+	}).Methods(http.MethodGet)
+}
+
+func writeProbe(w http.ResponseWriter, status int, msg string) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.WriteHeader(status)
+	w.Write([]byte(msg + "\n"))
+}
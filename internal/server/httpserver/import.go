@@ -0,0 +1,181 @@
+package httpserver
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"schedule/internal/domain/aggregate"
+	"schedule/pkg/rest"
+	"strconv"
+	"strings"
+)
+
+const (
+	contentTypeCSV    = "text/csv"
+	contentTypeNDJSON = "application/x-ndjson"
+	contentTypeJSONL  = "application/jsonl"
+)
+
+// Import is single request, limits keep it in memory and in single transaction.
+const (
+	maxImportBodySize = 10 << 20 // 10 MiB
+	maxImportLineSize = 1 << 20  // 1 MiB, json line
+	maxImportRows     = 10000
+)
+
+var (
+	errTooManyImportRows = fmt.Errorf("import must have at most %d rows", maxImportRows)
+	errTooLongImportLine = fmt.Errorf("line must be at most %d bytes", maxImportLineSize)
+)
+
+var csvImportColumns = []string{"user_id", "name", "duration", "period"}
+
+// parseCSVImportRows reads csv with header. Header must contain user_id, name, duration and period columns in any order.
+func parseCSVImportRows(r io.Reader) ([]aggregate.ScheduleImportRow, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, errors.New("empty csv")
+		}
+		return nil, fmt.Errorf("read csv header: %w", err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, name := range csvImportColumns {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("csv header: column %s is required", name)
+		}
+	}
+
+	var rows []aggregate.ScheduleImportRow
+
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			var parseErr *csv.ParseError
+			if !errors.As(err, &parseErr) {
+				return nil, fmt.Errorf("read csv: %w", err)
+			}
+			if rows = append(rows, aggregate.ScheduleImportRow{Line: parseErr.Line, Err: parseErr.Err}); len(rows) > maxImportRows {
+				return nil, errTooManyImportRows
+			}
+			continue
+		}
+
+		line, _ := reader.FieldPos(0)
+		row := aggregate.ScheduleImportRow{Line: line}
+
+		field := func(name string) string {
+			if i := columns[name]; i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+
+		req := &rest.CreateScheduleRequest{
+			Name:   field("name"),
+			Period: field("period"),
+		}
+		if req.UserId, err = strconv.Atoi(field("user_id")); err != nil {
+			row.Err = fmt.Errorf("invalid user_id: %w", err)
+		} else if duration := field("duration"); duration != "" {
+			if req.Duration, err = strconv.Atoi(duration); err != nil {
+				row.Err = fmt.Errorf("invalid duration: %w", err)
+			}
+		}
+
+		if row.Err == nil {
+			row.Schedule, row.Err = newDomainScheduleWithDuration(req)
+		}
+
+		if rows = append(rows, row); len(rows) > maxImportRows {
+			return nil, errTooManyImportRows
+		}
+	}
+
+	return rows, nil
+}
+
+// parseNDJSONImportRows reads one create_schedule_request object per line, empty lines are skipped.
+// Line longer than maxImportLineSize is row error, other lines are still read.
+func parseNDJSONImportRows(r io.Reader) ([]aggregate.ScheduleImportRow, error) {
+	splitter := &importLineSplitter{max: maxImportLineSize}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64<<10), maxImportLineSize)
+	scanner.Split(splitter.split)
+
+	var rows []aggregate.ScheduleImportRow
+
+	for line := 1; scanner.Scan(); line++ {
+		p := bytes.TrimSpace(scanner.Bytes())
+		if len(p) == 0 && !splitter.tooLong {
+			continue
+		}
+
+		row := aggregate.ScheduleImportRow{Line: line}
+
+		req := new(rest.CreateScheduleRequest)
+		if splitter.tooLong {
+			row.Err = errTooLongImportLine
+		} else if err := json.Unmarshal(p, req); err != nil {
+			row.Err = err
+		} else {
+			row.Schedule, row.Err = newDomainScheduleWithDuration(req)
+		}
+
+		if rows = append(rows, row); len(rows) > maxImportRows {
+			return nil, errTooManyImportRows
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read json lines: %w", err)
+	}
+
+	return rows, nil
+}
+
+// importLineSplitter is bufio.ScanLines which does not stop on line longer than max.
+// Such line is discarded up to its end and returned as empty token with tooLong set.
+type importLineSplitter struct {
+	max      int
+	skipping bool // rest of too long line is discarded
+	tooLong  bool // last token is too long line
+}
+
+func (s *importLineSplitter) split(data []byte, atEOF bool) (int, []byte, error) {
+	if s.skipping {
+		i := bytes.IndexByte(data, '\n')
+		if i < 0 && !atEOF {
+			return len(data), nil, nil
+		}
+		s.skipping, s.tooLong = false, true
+		if i < 0 {
+			return len(data), []byte{}, nil
+		}
+		return i + 1, []byte{}, nil
+	}
+
+	s.tooLong = false
+	advance, token, err := bufio.ScanLines(data, atEOF)
+	if advance == 0 && token == nil && err == nil && len(data) >= s.max { // scanner would stop with bufio.ErrTooLong
+		s.skipping = true
+		return len(data), nil, nil
+	}
+	return advance, token, err
+}
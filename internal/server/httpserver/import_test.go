@@ -0,0 +1,66 @@
+package httpserver
+
+import (
+	"github.com/stretchr/testify/require"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestImportRowsLimit(t *testing.T) {
+	const (
+		csvRow    = "1000000000000000,Test,10,1h\n"
+		ndjsonRow = `{"user_id": 1000000000000000, "name": "Test", "duration": 10, "period": "1h"}` + "\n"
+	)
+
+	parsers := []struct {
+		name  string
+		parse func(r io.Reader) error
+		body  func(rows int) string
+	}{
+		{
+			name: "csv",
+			parse: func(r io.Reader) error {
+				_, err := parseCSVImportRows(r)
+				return err
+			},
+			body: func(rows int) string { return "user_id,name,duration,period\n" + strings.Repeat(csvRow, rows) },
+		},
+		{
+			name: "json lines",
+			parse: func(r io.Reader) error {
+				_, err := parseNDJSONImportRows(r)
+				return err
+			},
+			body: func(rows int) string { return strings.Repeat(ndjsonRow, rows) },
+		},
+	}
+
+	for _, p := range parsers {
+		require.NoError(t, p.parse(strings.NewReader(p.body(maxImportRows))), p.name)
+		require.ErrorIs(t, p.parse(strings.NewReader(p.body(maxImportRows+1))), errTooManyImportRows, p.name)
+	}
+}
+
+func TestImportTooLongLine(t *testing.T) {
+	const row = `{"user_id": 1000000000000000, "name": "Test", "duration": 10, "period": "1h"}`
+	long := `{"user_id": 1000000000000000, "name": "` + strings.Repeat("a", maxImportLineSize) + `", "duration": 10, "period": "1h"}`
+
+	for name, body := range map[string]string{
+		"middle": row + "\n" + long + "\n" + row + "\n",
+		"last":   row + "\n\n" + row + "\n" + long,
+	} {
+		rows, err := parseNDJSONImportRows(strings.NewReader(body))
+		require.NoError(t, err, name)
+		require.Len(t, rows, 3, name)
+
+		for _, r := range rows {
+			if r.Err != nil {
+				require.ErrorIs(t, r.Err, errTooLongImportLine, name)
+				require.Equal(t, map[string]int{"middle": 2, "last": 4}[name], r.Line, name)
+				continue
+			}
+			require.NotNil(t, r.Schedule, name)
+		}
+	}
+}
@@ -5,20 +5,69 @@ import (
 	"encoding/json"
 	"log/slog"
 	"net/http"
+	"schedule/internal/util"
 	"schedule/pkg/contextx"
 	"schedule/pkg/errcodes"
 	"schedule/pkg/failure"
 	"schedule/pkg/rest"
 )
 
+const contentTypeProblemJSON = "application/problem+json"
+
 func writeAndLogErr(ctx context.Context, w http.ResponseWriter, err error) {
-	errCode, statusCode := getCodeFromError(err)
-	writeJson(ctx, w, rest.ErrorResponse{Error: errCode.String()}, statusCode)
+	writeProblem(ctx, w, newRESTErrorResponse(ctx, err))
 
 	l := contextx.GetLoggerOrDefault(ctx)
 	l.LogAttrs(ctx, slog.LevelError, "error handling request", slog.String("err", err.Error()))
 }
 
+// newRESTErrorResponse makes RFC 7807 problem details, internal error details are not exposed.
+func newRESTErrorResponse(ctx context.Context, err error) *rest.ErrorResponse {
+	errCode, statusCode := getCodeFromError(err)
+	if errCode != errcodes.Internal {
+		errCode = failure.GetReason(err)
+	}
+
+	resp := &rest.ErrorResponse{
+		Type:   "about:blank",
+		Title:  http.StatusText(statusCode),
+		Status: statusCode,
+		Error:  errCode.String(),
+		Reason: errCode.String(),
+	}
+
+	if statusCode != http.StatusInternalServerError {
+		resp.Detail = util.Ptr(failure.GetDetail(err))
+	}
+
+	if traceId := contextx.GetTraceId(ctx); traceId != "" {
+		resp.TraceId = util.Ptr(string(traceId))
+	}
+
+	if violations := failure.GetViolations(err); len(violations) > 0 {
+		restViolations := make([]rest.FieldViolation, len(violations))
+		for i, v := range violations {
+			restViolations[i] = rest.FieldViolation{
+				Field:       v.Field,
+				Description: v.Description,
+			}
+		}
+		resp.Violations = &restViolations
+	}
+
+	return resp
+}
+
+func writeProblem(ctx context.Context, w http.ResponseWriter, problem *rest.ErrorResponse) {
+	l := contextx.GetLoggerOrDefault(ctx)
+
+	w.Header().Set("Content-Type", contentTypeProblemJSON)
+	w.WriteHeader(problem.Status)
+	if err := json.NewEncoder(w).Encode(problem); err != nil {
+		l.LogAttrs(ctx, slog.LevelError, "json encode error", slog.String("err", err.Error()))
+	}
+}
+
 func writeJson(ctx context.Context, w http.ResponseWriter, v any, status int) {
 	l := contextx.GetLoggerOrDefault(ctx)
 
@@ -6,8 +6,31 @@ import (
 )
 
 func (s *Server) RegisterRoutes(rtr *mux.Router) {
+	v1 := rtr.PathPrefix("/v1").Subrouter()
+	v1.HandleFunc("/users/{userId}/schedules", s.listUserSchedules).Methods(http.MethodGet)
+	v1.HandleFunc("/users/{userId}/schedules", s.createUserSchedule).Methods(http.MethodPost)
+	v1.HandleFunc("/users/{userId}/schedules/preview", s.previewUserSchedule).Methods(http.MethodPost)
+	v1.HandleFunc("/users/{userId}/schedules/{id}", s.getSchedule).Methods(http.MethodGet)
+	v1.HandleFunc("/users/{userId}/schedules/{id}", s.updateSchedule).Methods(http.MethodPatch)
+	v1.HandleFunc("/users/{userId}/schedules/{id}", s.deleteSchedule).Methods(http.MethodDelete)
+	v1.HandleFunc("/users/{userId}/schedules/{id}/history", s.getScheduleHistory).Methods(http.MethodGet)
+	v1.HandleFunc("/users/{userId}/schedules/{id}/intakes", s.recordScheduleIntake).Methods(http.MethodPost)
+	v1.HandleFunc("/users/{userId}/next-takings", s.scheduleGetNextTakings).Methods(http.MethodGet)
+	v1.HandleFunc("/users/{userId}/day-plan", s.getDayPlan).Methods(http.MethodGet)
+	v1.HandleFunc("/users/{userId}/day-plan/consolidated", s.previewDayPlanConsolidation).Methods(http.MethodGet)
+	v1.HandleFunc("/users/{userId}/settings", s.getUserSettings).Methods(http.MethodGet)
+	v1.HandleFunc("/users/{userId}/settings", s.updateUserSettings).Methods(http.MethodPut)
+
+	// legacy routes, aliases of /v1
 	rtr.HandleFunc("/schedule", s.createSchedule).Methods(http.MethodPost)
 	rtr.HandleFunc("/schedule", s.getSchedule).Methods(http.MethodGet)
 	rtr.HandleFunc("/schedules", s.getUserSchedules).Methods(http.MethodGet)
+	rtr.HandleFunc("/schedules/list", s.listUserSchedules).Methods(http.MethodGet)
+	rtr.HandleFunc("/schedules/import", s.importSchedules).Methods(http.MethodPost)
 	rtr.HandleFunc("/next_taking", s.scheduleGetNextTakings).Methods(http.MethodGet)
+
+	rtr.HandleFunc("/fhir/MedicationRequest", s.createFHIRMedicationRequest).Methods(http.MethodPost)
+	rtr.HandleFunc("/fhir/MedicationRequest", s.searchFHIRMedicationRequests).Methods(http.MethodGet)
+	rtr.HandleFunc("/fhir/MedicationRequest/{id}", s.getFHIRMedicationRequest).Methods(http.MethodGet)
+	rtr.HandleFunc("/fhir/MedicationAdministration", s.searchFHIRMedicationAdministrations).Methods(http.MethodGet)
 }
@@ -0,0 +1,323 @@
+package httpserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/gorilla/mux"
+	"io"
+	"log/slog"
+	"net/http"
+	"schedule/internal/domain/aggregate"
+	"schedule/internal/domain/value"
+	"schedule/pkg/contextx"
+	"schedule/pkg/failure"
+	"schedule/pkg/fhir"
+	"strconv"
+	"strings"
+)
+
+func (s *ScheduleServer) createFHIRMedicationRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeAndLogFHIRErr(ctx, w, failure.NewInvalidRequestError(err.Error()))
+		return
+	}
+
+	resource := new(fhir.Resource)
+	if err := json.Unmarshal(body, resource); err != nil {
+		writeAndLogFHIRErr(ctx, w, failure.NewInvalidRequestError(err.Error()))
+		return
+	}
+
+	switch resource.ResourceType {
+	case fhir.ResourceTypeMedicationRequest:
+		s.createFHIRMedicationRequestFromResource(w, r, body)
+	case fhir.ResourceTypeBundle:
+		s.createFHIRMedicationRequestsFromBundle(w, r, body)
+	default:
+		writeAndLogFHIRErr(ctx, w, failure.NewInvalidRequestError(fmt.Sprintf("unsupported resource type '%s'", resource.ResourceType)))
+	}
+}
+
+func (s *ScheduleServer) createFHIRMedicationRequestFromResource(w http.ResponseWriter, r *http.Request, body []byte) {
+	ctx := r.Context()
+
+	req := new(fhir.MedicationRequest)
+	if err := json.Unmarshal(body, req); err != nil {
+		writeAndLogFHIRErr(ctx, w, failure.NewInvalidRequestError(err.Error()))
+		return
+	}
+
+	schedule, err := newDomainScheduleFromFHIR(req, s.schedule.Now(ctx))
+	if err != nil {
+		writeAndLogFHIRErr(ctx, w, failure.NewInvalidRequestError(err.Error()))
+		return
+	}
+
+	if err := schedule.Validate(s.schedule.Now(ctx)); err != nil {
+		writeAndLogFHIRErr(ctx, w, err)
+		return
+	}
+
+	id, _, err := s.schedule.Create(ctx, schedule) // warnings are in timetable
+	if err != nil {
+		writeAndLogFHIRErr(ctx, w, err)
+		return
+	}
+
+	timetable, err := s.schedule.GetTimetable(ctx, schedule.UserId, id)
+	if err != nil {
+		writeAndLogFHIRErr(ctx, w, err)
+		return
+	}
+
+	w.Header().Set("Location", fhirMedicationRequestReferencePrefix+strconv.Itoa(int(id)))
+	writeFHIR(ctx, w, newFHIRMedicationRequest(schedule.UserId, timetable, s.schedule.Now(ctx)), http.StatusCreated)
+}
+
+// createFHIRMedicationRequestsFromBundle creates all medication requests from bundle in single transaction.
+func (s *ScheduleServer) createFHIRMedicationRequestsFromBundle(w http.ResponseWriter, r *http.Request, body []byte) {
+	ctx := r.Context()
+
+	bundle := new(fhir.Bundle)
+	if err := json.Unmarshal(body, bundle); err != nil {
+		writeAndLogFHIRErr(ctx, w, failure.NewInvalidRequestError(err.Error()))
+		return
+	}
+
+	now := s.schedule.Now(ctx)
+
+	rows := make([]aggregate.ScheduleImportRow, len(bundle.Entry))
+	for i, entry := range bundle.Entry {
+		rows[i].Line = i
+
+		req := new(fhir.MedicationRequest)
+		if err := json.Unmarshal(entry.Resource, req); err != nil {
+			rows[i].Err = err
+			continue
+		}
+
+		rows[i].Schedule, rows[i].Err = newDomainScheduleFromFHIR(req, now)
+	}
+
+	results, err := s.schedule.Import(ctx, rows, aggregate.ScheduleImportModeAtomic)
+	if err != nil {
+		writeAndLogFHIRErr(ctx, w, err)
+		return
+	}
+
+	var issues []string
+	for _, result := range results {
+		if result.Err != nil {
+			issues = append(issues, fmt.Sprintf("entry[%d]: %s", result.Line, failure.GetDetail(result.Err)))
+		}
+	}
+	if len(issues) > 0 {
+		writeAndLogFHIRErr(ctx, w, failure.NewInvalidRequestError(strings.Join(issues, "; ")))
+		return
+	}
+
+	resp := &fhir.Bundle{
+		ResourceType: fhir.ResourceTypeBundle,
+		Type:         fhir.BundleTypeTransactionResponse,
+		Entry:        make([]fhir.BundleEntry, len(results)),
+	}
+	for i, result := range results {
+		resp.Entry[i].Response = &fhir.BundleEntryResponse{
+			Status:   "201 Created",
+			Location: fhirMedicationRequestReferencePrefix + strconv.Itoa(int(result.Id)),
+		}
+	}
+
+	writeFHIR(ctx, w, resp, http.StatusOK)
+}
+
+// getFHIRMedicationRequest reads resource by id, patient is optional and only narrows the read.
+func (s *ScheduleServer) getFHIRMedicationRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	scheduleId, err := value.ParseScheduleId(mux.Vars(r)["id"])
+	if err != nil {
+		writeAndLogFHIRErr(ctx, w, failure.NewInvalidRequestError(err.Error()))
+		return
+	}
+
+	var userId value.UserId
+	if patient := r.FormValue("patient"); patient != "" {
+		userId, err = parseFHIRPatient(patient)
+		if err != nil {
+			writeAndLogFHIRErr(ctx, w, failure.NewInvalidRequestError(err.Error()))
+			return
+		}
+	} else {
+		userId, err = s.schedule.GetOwner(ctx, scheduleId)
+		if err != nil {
+			writeAndLogFHIRErr(ctx, w, err)
+			return
+		}
+	}
+
+	timetable, err := s.schedule.GetTimetable(ctx, userId, scheduleId)
+	if err != nil {
+		writeAndLogFHIRErr(ctx, w, err)
+		return
+	}
+
+	writeFHIR(ctx, w, newFHIRMedicationRequest(userId, timetable, s.schedule.Now(ctx)), http.StatusOK)
+}
+
+func (s *ScheduleServer) searchFHIRMedicationRequests(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userId, err := parseFHIRPatient(r.FormValue("patient"))
+	if err != nil {
+		writeAndLogFHIRErr(ctx, w, failure.NewInvalidRequestError(err.Error()))
+		return
+	}
+
+	ids, err := s.schedule.GetByUser(ctx, userId)
+	if err != nil {
+		writeAndLogFHIRErr(ctx, w, err)
+		return
+	}
+
+	bundle := &fhir.Bundle{
+		ResourceType: fhir.ResourceTypeBundle,
+		Type:         fhir.BundleTypeSearchSet,
+		Total:        new(int),
+		Entry:        make([]fhir.BundleEntry, 0, len(ids)),
+	}
+
+	for _, id := range ids {
+		timetable, err := s.schedule.GetTimetable(ctx, userId, id)
+		if err != nil {
+			writeAndLogFHIRErr(ctx, w, err)
+			return
+		}
+
+		resource, err := json.Marshal(newFHIRMedicationRequest(userId, timetable, s.schedule.Now(ctx)))
+		if err != nil {
+			writeAndLogFHIRErr(ctx, w, failure.NewInternalError(err.Error()))
+			return
+		}
+
+		bundle.Entry = append(bundle.Entry, fhir.BundleEntry{
+			FullUrl:  fhirMedicationRequestReferencePrefix + strconv.Itoa(int(id)),
+			Resource: resource,
+		})
+	}
+	*bundle.Total = len(bundle.Entry)
+
+	writeFHIR(ctx, w, bundle, http.StatusOK)
+}
+
+// searchFHIRMedicationAdministrations exports recorded intakes of patient, request narrows them to single medication request.
+func (s *ScheduleServer) searchFHIRMedicationAdministrations(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userId, err := parseFHIRPatient(r.FormValue("patient"))
+	if err != nil {
+		writeAndLogFHIRErr(ctx, w, failure.NewInvalidRequestError(err.Error()))
+		return
+	}
+
+	var scheduleId value.ScheduleId
+	if request := r.FormValue("request"); request != "" {
+		scheduleId, err = value.ParseScheduleId(strings.TrimPrefix(request, fhirMedicationRequestReferencePrefix))
+		if err != nil {
+			writeAndLogFHIRErr(ctx, w, failure.NewInvalidRequestError(err.Error()))
+			return
+		}
+	}
+
+	intakes, err := s.schedule.GetIntakes(ctx, userId)
+	if err != nil {
+		writeAndLogFHIRErr(ctx, w, err)
+		return
+	}
+
+	bundle := &fhir.Bundle{
+		ResourceType: fhir.ResourceTypeBundle,
+		Type:         fhir.BundleTypeSearchSet,
+		Total:        new(int),
+		Entry:        make([]fhir.BundleEntry, 0, len(intakes)),
+	}
+
+	for _, intake := range intakes {
+		if scheduleId != 0 && intake.ScheduleId != scheduleId {
+			continue
+		}
+
+		resource, err := json.Marshal(newFHIRMedicationAdministration(intake))
+		if err != nil {
+			writeAndLogFHIRErr(ctx, w, failure.NewInternalError(err.Error()))
+			return
+		}
+
+		bundle.Entry = append(bundle.Entry, fhir.BundleEntry{
+			FullUrl:  fhir.ResourceTypeMedicationAdministration + "/" + strconv.FormatInt(intake.Id, 10),
+			Resource: resource,
+		})
+	}
+	*bundle.Total = len(bundle.Entry)
+
+	writeFHIR(ctx, w, bundle, http.StatusOK)
+}
+
+// parseFHIRPatient accepts both "Patient/123" and "123".
+func parseFHIRPatient(s string) (value.UserId, error) {
+	return value.ParseUserId(strings.TrimPrefix(s, fhirPatientReferencePrefix))
+}
+
+func writeAndLogFHIRErr(ctx context.Context, w http.ResponseWriter, err error) {
+	_, statusCode := getCodeFromError(err)
+
+	issueCode, diagnostics := fhir.IssueCodeException, "internal error"
+	switch statusCode {
+	case http.StatusBadRequest:
+		issueCode, diagnostics = fhir.IssueCodeInvalid, failure.GetDetail(err)
+	case http.StatusNotFound:
+		issueCode, diagnostics = fhir.IssueCodeNotFound, failure.GetDetail(err)
+	}
+
+	outcome := &fhir.OperationOutcome{
+		ResourceType: fhir.ResourceTypeOperationOutcome,
+	}
+
+	if violations := failure.GetViolations(err); len(violations) > 0 {
+		for _, v := range violations {
+			outcome.Issue = append(outcome.Issue, fhir.OperationOutcomeIssue{
+				Severity:    "error",
+				Code:        issueCode,
+				Diagnostics: v.Description,
+				Expression:  []string{v.Field},
+			})
+		}
+	} else {
+		outcome.Issue = []fhir.OperationOutcomeIssue{
+			{
+				Severity:    "error",
+				Code:        issueCode,
+				Diagnostics: diagnostics,
+			},
+		}
+	}
+
+	writeFHIR(ctx, w, outcome, statusCode)
+
+	l := contextx.GetLoggerOrDefault(ctx)
+	l.LogAttrs(ctx, slog.LevelError, "error handling request", slog.String("err", err.Error()))
+}
+
+func writeFHIR(ctx context.Context, w http.ResponseWriter, v any, status int) {
+	l := contextx.GetLoggerOrDefault(ctx)
+
+	w.Header().Set("Content-Type", fhir.ContentType)
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		l.LogAttrs(ctx, slog.LevelError, "json encode error", slog.String("err", err.Error()))
+	}
+}
@@ -2,11 +2,19 @@ package httpserver
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/gorilla/mux"
+	"mime"
 	"net/http"
+	"schedule/internal/domain/aggregate"
 	"schedule/internal/domain/value"
 	"schedule/internal/server"
+	"schedule/pkg/errcodes"
 	"schedule/pkg/failure"
 	"schedule/pkg/rest"
+	"strconv"
+	"time"
 )
 
 type ScheduleServer struct {
@@ -24,28 +32,146 @@ func (s *ScheduleServer) createSchedule(w http.ResponseWriter, r *http.Request)
 
 	req := new(rest.CreateScheduleRequest)
 	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
-		writeAndLogErr(ctx, w, failure.NewInvalidRequestError(err.Error()))
+		writeAndLogErr(ctx, w, failure.NewInvalidRequestErrorWithReason(errcodes.MalformedRequest, err.Error()))
 		return
 	}
 
 	schedule, err := newDomainScheduleWithDuration(req)
 	if err != nil {
-		writeAndLogErr(ctx, w, failure.NewInvalidRequestError(err.Error()))
+		writeAndLogErr(ctx, w, err)
 		return
 	}
 
-	if err := schedule.Validate(); err != nil {
-		writeAndLogErr(ctx, w, failure.NewInvalidRequestError(err.Error()))
+	if err := schedule.Validate(s.schedule.Now(ctx)); err != nil {
+		writeAndLogErr(ctx, w, err)
 		return
 	}
 
-	id, err := s.schedule.Create(r.Context(), schedule)
+	id, warnings, err := s.schedule.Create(r.Context(), schedule)
 	if err != nil {
 		writeAndLogErr(ctx, w, err)
 		return
 	}
 
-	writeJson(ctx, w, newRESTCreateScheduleResponse(id), http.StatusOK)
+	writeJson(ctx, w, newRESTCreateScheduleResponse(id, warnings), http.StatusOK)
+}
+
+func (s *ScheduleServer) createUserSchedule(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userId, err := value.ParseUserId(mux.Vars(r)["userId"])
+	if err != nil {
+		writeAndLogErr(ctx, w, newFieldError("userId", err))
+		return
+	}
+
+	req := new(rest.CreateUserScheduleRequest)
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		writeAndLogErr(ctx, w, failure.NewInvalidRequestErrorWithReason(errcodes.MalformedRequest, err.Error()))
+		return
+	}
+
+	schedule, err := newDomainUserScheduleWithDuration(userId, req)
+	if err != nil {
+		writeAndLogErr(ctx, w, err)
+		return
+	}
+
+	if err := schedule.Validate(s.schedule.Now(ctx)); err != nil {
+		writeAndLogErr(ctx, w, err)
+		return
+	}
+
+	id, warnings, err := s.schedule.Create(ctx, schedule)
+	if err != nil {
+		writeAndLogErr(ctx, w, err)
+		return
+	}
+
+	w.Header().Set("Location", fmt.Sprintf("/v1/users/%d/schedules/%d", userId, id))
+	writeJson(ctx, w, newRESTCreateScheduleResponse(id, warnings), http.StatusCreated)
+}
+
+func (s *ScheduleServer) previewUserSchedule(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userId, err := value.ParseUserId(mux.Vars(r)["userId"])
+	if err != nil {
+		writeAndLogErr(ctx, w, newFieldError("userId", err))
+		return
+	}
+
+	var days int
+	if v := r.FormValue("days"); v != "" {
+		if days, err = strconv.Atoi(v); err != nil {
+			writeAndLogErr(ctx, w, newFieldError("days", err))
+			return
+		}
+	}
+
+	req := new(rest.CreateUserScheduleRequest)
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		writeAndLogErr(ctx, w, failure.NewInvalidRequestErrorWithReason(errcodes.MalformedRequest, err.Error()))
+		return
+	}
+
+	schedule, err := newDomainUserScheduleWithDuration(userId, req)
+	if err != nil {
+		writeAndLogErr(ctx, w, err)
+		return
+	}
+
+	preview, err := s.schedule.Preview(ctx, schedule, days)
+	if err != nil {
+		writeAndLogErr(ctx, w, err)
+		return
+	}
+
+	writeJson(ctx, w, newRESTSchedulePreviewResponse(preview), http.StatusOK)
+}
+
+func (s *ScheduleServer) importSchedules(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	mode, ok := aggregate.ParseScheduleImportMode(r.FormValue("mode"))
+	if !ok {
+		writeAndLogErr(ctx, w, newFieldError("mode", errors.New("unknown import mode")))
+		return
+	}
+
+	contentType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxImportBodySize)
+
+	var (
+		rows []aggregate.ScheduleImportRow
+		err  error
+	)
+	switch contentType {
+	case contentTypeCSV:
+		rows, err = parseCSVImportRows(r.Body)
+	case contentTypeNDJSON, contentTypeJSONL:
+		rows, err = parseNDJSONImportRows(r.Body)
+	default:
+		writeAndLogErr(ctx, w, failure.NewInvalidRequestErrorWithReason(errcodes.UnsupportedMediaType, fmt.Sprintf("unsupported content type '%s'", contentType)))
+		return
+	}
+	if errors.Is(err, errTooManyImportRows) {
+		writeAndLogErr(ctx, w, failure.NewValidationError(failure.Violation{Field: "rows", Description: err.Error()}))
+		return
+	}
+	if err != nil {
+		writeAndLogErr(ctx, w, failure.NewInvalidRequestErrorWithReason(errcodes.MalformedRequest, err.Error()))
+		return
+	}
+
+	results, err := s.schedule.Import(ctx, rows, mode)
+	if err != nil {
+		writeAndLogErr(ctx, w, err)
+		return
+	}
+
+	writeJson(ctx, w, newRESTImportSchedulesResponse(results), http.StatusOK)
 }
 
 func (s *ScheduleServer) getUserSchedules(w http.ResponseWriter, r *http.Request) {
@@ -53,7 +179,7 @@ func (s *ScheduleServer) getUserSchedules(w http.ResponseWriter, r *http.Request
 
 	userId, err := value.ParseUserId(r.FormValue("user_id"))
 	if err != nil {
-		writeAndLogErr(ctx, w, failure.NewInvalidRequestError(err.Error()))
+		writeAndLogErr(ctx, w, newFieldError("user_id", err))
 		return
 	}
 
@@ -66,17 +192,55 @@ func (s *ScheduleServer) getUserSchedules(w http.ResponseWriter, r *http.Request
 	writeJson(ctx, w, resp, http.StatusOK)
 }
 
+func (s *ScheduleServer) listUserSchedules(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if err := r.ParseForm(); err != nil {
+		writeAndLogErr(ctx, w, failure.NewInvalidRequestErrorWithReason(errcodes.MalformedRequest, err.Error()))
+		return
+	}
+	if userId, ok := mux.Vars(r)["userId"]; ok {
+		r.Form.Set("user_id", userId)
+	}
+
+	filter, err := newDomainScheduleListFilter(r.Form)
+	if err != nil {
+		writeAndLogErr(ctx, w, err)
+		return
+	}
+
+	list, err := s.schedule.List(ctx, filter)
+	if err != nil {
+		writeAndLogErr(ctx, w, err)
+		return
+	}
+
+	writeJson(ctx, w, newRESTSchedulesPageResponse(list), http.StatusOK)
+}
+
 func (s *ScheduleServer) getSchedule(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	userId, err := value.ParseUserId(r.FormValue("user_id"))
+	userId, scheduleId, err := parseScheduleParams(r)
 	if err != nil {
-		writeAndLogErr(ctx, w, failure.NewInvalidRequestError(err.Error()))
+		writeAndLogErr(ctx, w, err)
 		return
 	}
-	scheduleId, err := value.ParseScheduleId(r.FormValue("schedule_id"))
+
+	explain, err := parseExplainParam(r)
 	if err != nil {
-		writeAndLogErr(ctx, w, failure.NewInvalidRequestError(err.Error()))
+		writeAndLogErr(ctx, w, err)
+		return
+	}
+
+	if explain {
+		scheduleTimetable, decisions, err := s.schedule.ExplainTimetable(ctx, userId, scheduleId)
+		if err != nil {
+			writeAndLogErr(ctx, w, err)
+			return
+		}
+
+		writeJson(ctx, w, newRESTScheduleExplainResponse(scheduleTimetable, decisions), http.StatusOK)
 		return
 	}
 
@@ -89,12 +253,123 @@ func (s *ScheduleServer) getSchedule(w http.ResponseWriter, r *http.Request) {
 	writeJson(ctx, w, newRESTScheduleResponse(scheduleTimetable), http.StatusOK)
 }
 
+func (s *ScheduleServer) updateSchedule(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userId, scheduleId, err := parseScheduleParams(r)
+	if err != nil {
+		writeAndLogErr(ctx, w, err)
+		return
+	}
+
+	req := new(rest.UpdateScheduleRequest)
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		writeAndLogErr(ctx, w, failure.NewInvalidRequestErrorWithReason(errcodes.MalformedRequest, err.Error()))
+		return
+	}
+
+	update, err := newDomainScheduleUpdate(req)
+	if err != nil {
+		writeAndLogErr(ctx, w, err)
+		return
+	}
+
+	scheduleTimetable, err := s.schedule.Update(ctx, userId, scheduleId, update)
+	if err != nil {
+		writeAndLogErr(ctx, w, err)
+		return
+	}
+
+	writeJson(ctx, w, newRESTScheduleResponse(scheduleTimetable), http.StatusOK)
+}
+
+func (s *ScheduleServer) recordScheduleIntake(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userId, scheduleId, err := parseScheduleParams(r)
+	if err != nil {
+		writeAndLogErr(ctx, w, err)
+		return
+	}
+
+	req := new(rest.RecordIntakeRequest)
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		writeAndLogErr(ctx, w, failure.NewInvalidRequestErrorWithReason(errcodes.MalformedRequest, err.Error()))
+		return
+	}
+
+	var takenAt time.Time
+	if req.TakenAt != nil {
+		takenAt = *req.TakenAt
+	}
+
+	scheduleTimetable, err := s.schedule.RecordIntake(ctx, userId, scheduleId, takenAt)
+	if err != nil {
+		writeAndLogErr(ctx, w, err)
+		return
+	}
+
+	writeJson(ctx, w, newRESTScheduleResponse(scheduleTimetable), http.StatusOK)
+}
+
+func (s *ScheduleServer) deleteSchedule(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userId, scheduleId, err := parseScheduleParams(r)
+	if err != nil {
+		writeAndLogErr(ctx, w, err)
+		return
+	}
+
+	if err := s.schedule.Delete(ctx, userId, scheduleId); err != nil {
+		writeAndLogErr(ctx, w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *ScheduleServer) getScheduleHistory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userId, scheduleId, err := parseScheduleParams(r)
+	if err != nil {
+		writeAndLogErr(ctx, w, err)
+		return
+	}
+
+	records, err := s.schedule.GetHistory(ctx, userId, scheduleId)
+	if err != nil {
+		writeAndLogErr(ctx, w, err)
+		return
+	}
+
+	writeJson(ctx, w, newRESTAuditRecordsResponse(records), http.StatusOK)
+}
+
 func (s *ScheduleServer) scheduleGetNextTakings(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	userId, err := value.ParseUserId(r.FormValue("user_id"))
+	userId, err := parseUserIdParam(r)
 	if err != nil {
-		writeAndLogErr(ctx, w, failure.NewInvalidRequestError(err.Error()))
+		writeAndLogErr(ctx, w, err)
+		return
+	}
+
+	explain, err := parseExplainParam(r)
+	if err != nil {
+		writeAndLogErr(ctx, w, err)
+		return
+	}
+
+	if explain {
+		schedules, decisions, err := s.schedule.ExplainNextTakings(ctx, userId)
+		if err != nil {
+			writeAndLogErr(ctx, w, err)
+			return
+		}
+
+		writeJson(ctx, w, newRESTNextTakingExplainResponse(schedules, decisions), http.StatusOK)
 		return
 	}
 
@@ -106,3 +381,151 @@ func (s *ScheduleServer) scheduleGetNextTakings(w http.ResponseWriter, r *http.R
 
 	writeJson(ctx, w, newRESTNextTakingResponse(schedules), http.StatusOK)
 }
+
+func (s *ScheduleServer) getDayPlan(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userId, date, err := parseDayPlanParams(r)
+	if err != nil {
+		writeAndLogErr(ctx, w, err)
+		return
+	}
+
+	plan, err := s.schedule.GetDayPlan(ctx, userId, date)
+	if err != nil {
+		writeAndLogErr(ctx, w, err)
+		return
+	}
+
+	writeJson(ctx, w, newRESTDayPlanResponse(plan), http.StatusOK)
+}
+
+func (s *ScheduleServer) previewDayPlanConsolidation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userId, date, err := parseDayPlanParams(r)
+	if err != nil {
+		writeAndLogErr(ctx, w, err)
+		return
+	}
+
+	plan, err := s.schedule.PreviewConsolidatedDayPlan(ctx, userId, date)
+	if err != nil {
+		writeAndLogErr(ctx, w, err)
+		return
+	}
+
+	writeJson(ctx, w, newRESTDayPlanResponse(plan), http.StatusOK)
+}
+
+// parseDayPlanParams reads user id and optional date, zero date means today.
+func parseDayPlanParams(r *http.Request) (value.UserId, time.Time, error) {
+	userId, err := parseUserIdParam(r)
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+
+	var date time.Time
+	if v := r.FormValue("date"); v != "" {
+		if date, err = time.Parse(time.DateOnly, v); err != nil {
+			return 0, time.Time{}, newFieldError("date", err)
+		}
+	}
+
+	return userId, date, nil
+}
+
+func (s *ScheduleServer) getUserSettings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userId, err := parseUserIdParam(r)
+	if err != nil {
+		writeAndLogErr(ctx, w, err)
+		return
+	}
+
+	settings, err := s.schedule.GetSettings(ctx, userId)
+	if err != nil {
+		writeAndLogErr(ctx, w, err)
+		return
+	}
+
+	writeJson(ctx, w, newRESTUserSettings(settings), http.StatusOK)
+}
+
+func (s *ScheduleServer) updateUserSettings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userId, err := parseUserIdParam(r)
+	if err != nil {
+		writeAndLogErr(ctx, w, err)
+		return
+	}
+
+	req := new(rest.UserSettings)
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		writeAndLogErr(ctx, w, failure.NewInvalidRequestErrorWithReason(errcodes.MalformedRequest, err.Error()))
+		return
+	}
+
+	settings := newDomainUserSettings(userId, req)
+	if err := s.schedule.UpdateSettings(ctx, settings); err != nil {
+		writeAndLogErr(ctx, w, err)
+		return
+	}
+
+	writeJson(ctx, w, newRESTUserSettings(settings), http.StatusOK)
+}
+
+// parseExplainParam reads optional explain flag from query.
+func parseExplainParam(r *http.Request) (bool, error) {
+	v := r.FormValue("explain")
+	if v == "" {
+		return false, nil
+	}
+
+	explain, err := strconv.ParseBool(v)
+	if err != nil {
+		return false, newFieldError("explain", err)
+	}
+	return explain, nil
+}
+
+// parseUserIdParam reads user id from path of /v1 routes or from query of legacy routes.
+func parseUserIdParam(r *http.Request) (value.UserId, error) {
+	if s, ok := mux.Vars(r)["userId"]; ok {
+		userId, err := value.ParseUserId(s)
+		if err != nil {
+			return 0, newFieldError("userId", err)
+		}
+		return userId, nil
+	}
+
+	userId, err := value.ParseUserId(r.FormValue("user_id"))
+	if err != nil {
+		return 0, newFieldError("user_id", err)
+	}
+	return userId, nil
+}
+
+// parseScheduleParams reads user and schedule ids from path of /v1 routes or from query of legacy routes.
+func parseScheduleParams(r *http.Request) (value.UserId, value.ScheduleId, error) {
+	userId, err := parseUserIdParam(r)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if s, ok := mux.Vars(r)["id"]; ok {
+		scheduleId, err := value.ParseScheduleId(s)
+		if err != nil {
+			return 0, 0, newFieldError("id", err)
+		}
+		return userId, scheduleId, nil
+	}
+
+	scheduleId, err := value.ParseScheduleId(r.FormValue("schedule_id"))
+	if err != nil {
+		return 0, 0, newFieldError("schedule_id", err)
+	}
+	return userId, scheduleId, nil
+}
@@ -3,12 +3,30 @@ package server
 import (
 	"context"
 	"schedule/internal/domain/aggregate"
+	"schedule/internal/domain/entity"
 	"schedule/internal/domain/value"
+	"time"
 )
 
 type ScheduleUsecase interface {
-	Create(ctx context.Context, schedule *aggregate.ScheduleWithDuration) (value.ScheduleId, error)
+	Create(ctx context.Context, schedule *aggregate.ScheduleWithDuration) (value.ScheduleId, []aggregate.ScheduleWarning, error)
+	Import(ctx context.Context, rows []aggregate.ScheduleImportRow, mode aggregate.ScheduleImportMode) ([]aggregate.ScheduleImportResult, error)
 	GetByUser(ctx context.Context, userId value.UserId) ([]value.ScheduleId, error)
+	List(ctx context.Context, filter *aggregate.ScheduleListFilter) (*aggregate.ScheduleList, error)
+	Preview(ctx context.Context, schedule *aggregate.ScheduleWithDuration, days int) (*aggregate.SchedulePreview, error)
+	GetOwner(ctx context.Context, scheduleId value.ScheduleId) (value.UserId, error)
 	GetTimetable(ctx context.Context, userId value.UserId, scheduleId value.ScheduleId) (*aggregate.ScheduleWithTimetable, error)
+	ExplainTimetable(ctx context.Context, userId value.UserId, scheduleId value.ScheduleId) (*aggregate.ScheduleWithTimetable, []aggregate.ScheduleSlotDecision, error)
+	Update(ctx context.Context, userId value.UserId, scheduleId value.ScheduleId, update *aggregate.ScheduleUpdate) (*aggregate.ScheduleWithTimetable, error)
+	RecordIntake(ctx context.Context, userId value.UserId, scheduleId value.ScheduleId, takenAt time.Time) (*aggregate.ScheduleWithTimetable, error)
+	GetIntakes(ctx context.Context, userId value.UserId) ([]*entity.Intake, error)
+	Delete(ctx context.Context, userId value.UserId, scheduleId value.ScheduleId) error
+	GetHistory(ctx context.Context, userId value.UserId, scheduleId value.ScheduleId) ([]*entity.AuditRecord, error)
 	GetNextTakings(ctx context.Context, userId value.UserId) ([]aggregate.ScheduleNextTaking, error)
+	GetDayPlan(ctx context.Context, userId value.UserId, date time.Time) (*aggregate.DayPlan, error)
+	PreviewConsolidatedDayPlan(ctx context.Context, userId value.UserId, date time.Time) (*aggregate.DayPlan, error)
+	GetSettings(ctx context.Context, userId value.UserId) (*entity.UserSettings, error)
+	UpdateSettings(ctx context.Context, settings *entity.UserSettings) error
+	ExplainNextTakings(ctx context.Context, userId value.UserId) ([]aggregate.ScheduleNextTaking, []aggregate.ScheduleSlotDecision, error)
+	Now(ctx context.Context) time.Time // in user location
 }
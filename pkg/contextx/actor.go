@@ -0,0 +1,39 @@
+package contextx
+
+import "context"
+
+// Actor is who makes request, recorded in audit. It is taken from authenticated client identity.
+type Actor string
+
+const AnonymousActor Actor = "anonymous"
+
+// ClaimedActor is actor named by client itself, it is not verified and recorded in audit only as advisory.
+type ClaimedActor string
+
+type (
+	contextKeyActor        struct{}
+	contextKeyClaimedActor struct{}
+)
+
+func WithActor(ctx context.Context, actor Actor) context.Context {
+	return context.WithValue(ctx, contextKeyActor{}, actor)
+}
+
+func GetActorOrDefault(ctx context.Context) Actor {
+	if v, ok := ctx.Value(contextKeyActor{}).(Actor); ok && v != "" {
+		return v
+	}
+	return AnonymousActor
+}
+
+func WithClaimedActor(ctx context.Context, actor ClaimedActor) context.Context {
+	return context.WithValue(ctx, contextKeyClaimedActor{}, actor)
+}
+
+// GetClaimedActor returns empty actor if client has not named it.
+func GetClaimedActor(ctx context.Context) ClaimedActor {
+	if v, ok := ctx.Value(contextKeyClaimedActor{}).(ClaimedActor); ok {
+		return v
+	}
+	return ""
+}
@@ -0,0 +1,38 @@
+package contextx
+
+import "context"
+
+// ClientIdentity is subject of verified client certificate.
+type ClientIdentity struct {
+	CommonName string
+	DNSNames   []string
+	URIs       []string // e.g. spiffe ids
+}
+
+// Name is first uri or common name of client certificate, it identifies client for rate limiting and audit.
+func (i *ClientIdentity) Name() string {
+	if len(i.URIs) > 0 {
+		return i.URIs[0]
+	}
+	if i.CommonName != "" {
+		return i.CommonName
+	}
+	if len(i.DNSNames) > 0 {
+		return i.DNSNames[0]
+	}
+	return ""
+}
+
+type contextKeyClientIdentity struct{}
+
+func WithClientIdentity(ctx context.Context, identity *ClientIdentity) context.Context {
+	return context.WithValue(ctx, contextKeyClientIdentity{}, identity)
+}
+
+// GetClientIdentity returns nil if client is not authenticated by certificate.
+func GetClientIdentity(ctx context.Context) *ClientIdentity {
+	if v, ok := ctx.Value(contextKeyClientIdentity{}).(*ClientIdentity); ok {
+		return v
+	}
+	return nil
+}
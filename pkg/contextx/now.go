@@ -0,0 +1,18 @@
+package contextx
+
+import (
+	"context"
+	"time"
+)
+
+type contextKeyNow struct{}
+
+// WithNowOverride makes usecase to treat now as current time, it is used by qa for debugging.
+func WithNowOverride(ctx context.Context, now time.Time) context.Context {
+	return context.WithValue(ctx, contextKeyNow{}, now)
+}
+
+func GetNowOverride(ctx context.Context) (time.Time, bool) {
+	now, ok := ctx.Value(contextKeyNow{}).(time.Time)
+	return now, ok
+}
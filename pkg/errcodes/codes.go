@@ -1,5 +1,6 @@
 package errcodes
 
+// Code is machine-readable reason of error, returned in api responses.
 type Code string
 
 func (e Code) String() string {
@@ -7,7 +8,14 @@ func (e Code) String() string {
 }
 
 const (
-	Internal   Code = "InternalError"
-	NotFound   Code = "NotFound"
-	Validation Code = "ValidationError"
+	Internal             Code = "InternalError"
+	NotFound             Code = "NotFound"
+	Validation           Code = "ValidationError"
+	MalformedRequest     Code = "MalformedRequest"
+	UnsupportedMediaType Code = "UnsupportedMediaType"
+	InvalidCursor        Code = "InvalidCursor"
+	RateLimited          Code = "RateLimited"
 )
+
+// Domain of errors in google.rpc.ErrorInfo.
+const Domain = "schedule"
@@ -1,15 +1,48 @@
 package failure
 
+import (
+	"errors"
+	"schedule/pkg/errcodes"
+)
+
 type baseError struct {
-	Msg string
+	Msg    string
+	Reason errcodes.Code
 }
 
-func newBaseError(msg string) baseError {
+func newBaseError(msg string, reason errcodes.Code) baseError {
 	return baseError{
-		Msg: msg,
+		Msg:    msg,
+		Reason: reason,
 	}
 }
 
 func (e baseError) Error() string {
 	return e.Msg
 }
+
+func (e baseError) base() baseError {
+	return e
+}
+
+type baser interface {
+	base() baseError
+}
+
+// GetReason returns machine-readable reason of error, errcodes.Internal for unknown errors.
+func GetReason(err error) errcodes.Code {
+	var b baser
+	if errors.As(err, &b) && b.base().Reason != "" {
+		return b.base().Reason
+	}
+	return errcodes.Internal
+}
+
+// GetDetail returns error message without error type prefix.
+func GetDetail(err error) string {
+	var b baser
+	if errors.As(err, &b) {
+		return b.base().Msg
+	}
+	return err.Error()
+}
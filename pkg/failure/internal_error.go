@@ -1,6 +1,9 @@
 package failure
 
-import "errors"
+import (
+	"errors"
+	"schedule/pkg/errcodes"
+)
 
 type InternalError struct {
 	baseError
@@ -8,7 +11,7 @@ type InternalError struct {
 
 func NewInternalError(msg string) error {
 	return InternalError{
-		baseError: newBaseError(msg),
+		baseError: newBaseError(msg, errcodes.Internal),
 	}
 }
 
@@ -1,14 +1,44 @@
 package failure
 
-import "errors"
+import (
+	"errors"
+	"schedule/pkg/errcodes"
+	"strings"
+)
 
 type InvalidRequestError struct {
 	baseError
+	Violations []Violation
+}
+
+// Violation describes invalid field of request.
+type Violation struct {
+	Field       string
+	Description string
 }
 
 func NewInvalidRequestError(msg string) error {
 	return InvalidRequestError{
-		baseError: newBaseError(msg),
+		baseError: newBaseError(msg, errcodes.Validation),
+	}
+}
+
+func NewInvalidRequestErrorWithReason(reason errcodes.Code, msg string) error {
+	return InvalidRequestError{
+		baseError: newBaseError(msg, reason),
+	}
+}
+
+// NewValidationError returns InvalidRequestError with all violations.
+func NewValidationError(violations ...Violation) error {
+	descriptions := make([]string, len(violations))
+	for i, v := range violations {
+		descriptions[i] = v.Description
+	}
+
+	return InvalidRequestError{
+		baseError:  newBaseError(strings.Join(descriptions, "; "), errcodes.Validation),
+		Violations: violations,
 	}
 }
 
@@ -19,3 +49,11 @@ func (err InvalidRequestError) Error() string {
 func IsInvalidRequestError(err error) bool {
 	return errors.As(err, new(InvalidRequestError))
 }
+
+func GetViolations(err error) []Violation {
+	var e InvalidRequestError
+	if errors.As(err, &e) {
+		return e.Violations
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package failure
 
 import (
 	"errors"
+	"schedule/pkg/errcodes"
 )
 
 type NotFoundError struct {
@@ -10,7 +11,7 @@ type NotFoundError struct {
 
 func NewNotFoundError(msg string) error {
 	return NotFoundError{
-		baseError: newBaseError(msg),
+		baseError: newBaseError(msg, errcodes.NotFound),
 	}
 }
 
@@ -0,0 +1,149 @@
+// Package fhir contains subset of FHIR R4 resources used by schedule service.
+// See https://hl7.org/fhir/R4/
+package fhir
+
+import "encoding/json"
+
+const ContentType = "application/fhir+json"
+
+const (
+	ResourceTypeBundle                   = "Bundle"
+	ResourceTypeMedicationAdministration = "MedicationAdministration"
+	ResourceTypeMedicationRequest        = "MedicationRequest"
+	ResourceTypeOperationOutcome         = "OperationOutcome"
+)
+
+const (
+	BundleTypeCollection          = "collection"
+	BundleTypeTransaction         = "transaction"
+	BundleTypeTransactionResponse = "transaction-response"
+	BundleTypeSearchSet           = "searchset"
+)
+
+const (
+	MedicationRequestStatusActive    = "active"
+	MedicationRequestStatusCompleted = "completed"
+
+	MedicationRequestIntentOrder = "order"
+
+	MedicationAdministrationStatusCompleted = "completed"
+)
+
+// Units of time https://hl7.org/fhir/R4/valueset-units-of-time.html
+const (
+	UnitSecond = "s"
+	UnitMinute = "min"
+	UnitHour   = "h"
+	UnitDay    = "d"
+	UnitWeek   = "wk"
+)
+
+// Resource used for detect type of incoming resource.
+type Resource struct {
+	ResourceType string `json:"resourceType"`
+}
+
+type Bundle struct {
+	ResourceType string        `json:"resourceType"`
+	Type         string        `json:"type"`
+	Total        *int          `json:"total,omitempty"`
+	Entry        []BundleEntry `json:"entry,omitempty"`
+}
+
+type BundleEntry struct {
+	FullUrl  string               `json:"fullUrl,omitempty"`
+	Resource json.RawMessage      `json:"resource,omitempty"`
+	Response *BundleEntryResponse `json:"response,omitempty"`
+}
+
+type BundleEntryResponse struct {
+	Status   string          `json:"status"`
+	Location string          `json:"location,omitempty"`
+	Outcome  json.RawMessage `json:"outcome,omitempty"`
+}
+
+type MedicationRequest struct {
+	ResourceType              string           `json:"resourceType"`
+	Id                        string           `json:"id,omitempty"`
+	Status                    string           `json:"status"`
+	Intent                    string           `json:"intent"`
+	MedicationCodeableConcept *CodeableConcept `json:"medicationCodeableConcept,omitempty"`
+	Subject                   Reference        `json:"subject"`
+	AuthoredOn                string           `json:"authoredOn,omitempty"`
+	DosageInstruction         []Dosage         `json:"dosageInstruction,omitempty"`
+}
+
+type MedicationAdministration struct {
+	ResourceType              string           `json:"resourceType"`
+	Id                        string           `json:"id,omitempty"`
+	Status                    string           `json:"status"`
+	MedicationCodeableConcept *CodeableConcept `json:"medicationCodeableConcept,omitempty"`
+	Subject                   Reference        `json:"subject"`
+	EffectiveDateTime         string           `json:"effectiveDateTime,omitempty"`
+	Request                   *Reference       `json:"request,omitempty"`
+}
+
+type CodeableConcept struct {
+	Coding []Coding `json:"coding,omitempty"`
+	Text   string   `json:"text,omitempty"`
+}
+
+type Coding struct {
+	System  string `json:"system,omitempty"`
+	Code    string `json:"code,omitempty"`
+	Display string `json:"display,omitempty"`
+}
+
+type Reference struct {
+	Reference string `json:"reference,omitempty"`
+	Display   string `json:"display,omitempty"`
+}
+
+type Dosage struct {
+	Text   string  `json:"text,omitempty"`
+	Timing *Timing `json:"timing,omitempty"`
+}
+
+type Timing struct {
+	Repeat *TimingRepeat `json:"repeat,omitempty"`
+}
+
+type TimingRepeat struct {
+	BoundsDuration *Duration `json:"boundsDuration,omitempty"`
+	BoundsPeriod   *Period   `json:"boundsPeriod,omitempty"`
+	Count          *int      `json:"count,omitempty"`
+	Frequency      *int      `json:"frequency,omitempty"`
+	Period         *float64  `json:"period,omitempty"`
+	PeriodUnit     string    `json:"periodUnit,omitempty"`
+	TimeOfDay      []string  `json:"timeOfDay,omitempty"`
+}
+
+type Period struct {
+	Start string `json:"start,omitempty"`
+	End   string `json:"end,omitempty"`
+}
+
+type Duration struct {
+	Value float64 `json:"value"`
+	Unit  string  `json:"unit,omitempty"`
+	Code  string  `json:"code,omitempty"`
+}
+
+type OperationOutcome struct {
+	ResourceType string                  `json:"resourceType"`
+	Issue        []OperationOutcomeIssue `json:"issue"`
+}
+
+// Issue types https://hl7.org/fhir/R4/valueset-issue-type.html
+const (
+	IssueCodeInvalid   = "invalid"
+	IssueCodeNotFound  = "not-found"
+	IssueCodeException = "exception"
+)
+
+type OperationOutcomeIssue struct {
+	Severity    string   `json:"severity"`
+	Code        string   `json:"code"`
+	Diagnostics string   `json:"diagnostics,omitempty"`
+	Expression  []string `json:"expression,omitempty"`
+}
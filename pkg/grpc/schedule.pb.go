@@ -341,6 +341,234 @@ func (x *GetSchedulesReply) GetScheduleIds() []int32 {
 	return nil
 }
 
+type ListSchedulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	NameContains  string                 `protobuf:"bytes,2,opt,name=nameContains,proto3" json:"nameContains,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`        // active, expired or empty for any
+	EndAtFrom     int64                  `protobuf:"varint,4,opt,name=endAtFrom,proto3" json:"endAtFrom,omitempty"` // unix time of min end date, 0 - not set
+	EndAtTo       int64                  `protobuf:"varint,5,opt,name=endAtTo,proto3" json:"endAtTo,omitempty"`     // unix time of max end date, 0 - not set
+	Sort          string                 `protobuf:"bytes,6,opt,name=sort,proto3" json:"sort,omitempty"`            // id, name, end_at
+	Desc          bool                   `protobuf:"varint,7,opt,name=desc,proto3" json:"desc,omitempty"`
+	PageSize      int32                  `protobuf:"varint,8,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageToken     string                 `protobuf:"bytes,9,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSchedulesRequest) Reset() {
+	*x = ListSchedulesRequest{}
+	mi := &file_schedule_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSchedulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSchedulesRequest) ProtoMessage() {}
+
+func (x *ListSchedulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_schedule_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSchedulesRequest.ProtoReflect.Descriptor instead.
+func (*ListSchedulesRequest) Descriptor() ([]byte, []int) {
+	return file_schedule_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListSchedulesRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ListSchedulesRequest) GetNameContains() string {
+	if x != nil {
+		return x.NameContains
+	}
+	return ""
+}
+
+func (x *ListSchedulesRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListSchedulesRequest) GetEndAtFrom() int64 {
+	if x != nil {
+		return x.EndAtFrom
+	}
+	return 0
+}
+
+func (x *ListSchedulesRequest) GetEndAtTo() int64 {
+	if x != nil {
+		return x.EndAtTo
+	}
+	return 0
+}
+
+func (x *ListSchedulesRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *ListSchedulesRequest) GetDesc() bool {
+	if x != nil {
+		return x.Desc
+	}
+	return false
+}
+
+func (x *ListSchedulesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListSchedulesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListSchedulesReply struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Items         []*ListSchedulesReplyItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NextPageToken string                    `protobuf:"bytes,2,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSchedulesReply) Reset() {
+	*x = ListSchedulesReply{}
+	mi := &file_schedule_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSchedulesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSchedulesReply) ProtoMessage() {}
+
+func (x *ListSchedulesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_schedule_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSchedulesReply.ProtoReflect.Descriptor instead.
+func (*ListSchedulesReply) Descriptor() ([]byte, []int) {
+	return file_schedule_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListSchedulesReply) GetItems() []*ListSchedulesReplyItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListSchedulesReply) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ListSchedulesReplyItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	EndAt         int64                  `protobuf:"varint,3,opt,name=endAt,proto3" json:"endAt,omitempty"`
+	Period        int64                  `protobuf:"varint,4,opt,name=period,proto3" json:"period,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSchedulesReplyItem) Reset() {
+	*x = ListSchedulesReplyItem{}
+	mi := &file_schedule_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSchedulesReplyItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSchedulesReplyItem) ProtoMessage() {}
+
+func (x *ListSchedulesReplyItem) ProtoReflect() protoreflect.Message {
+	mi := &file_schedule_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSchedulesReplyItem.ProtoReflect.Descriptor instead.
+func (*ListSchedulesReplyItem) Descriptor() ([]byte, []int) {
+	return file_schedule_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListSchedulesReplyItem) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ListSchedulesReplyItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListSchedulesReplyItem) GetEndAt() int64 {
+	if x != nil {
+		return x.EndAt
+	}
+	return 0
+}
+
+func (x *ListSchedulesReplyItem) GetPeriod() int64 {
+	if x != nil {
+		return x.Period
+	}
+	return 0
+}
+
 type GetNextTakingsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        int64                  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
@@ -350,7 +578,7 @@ type GetNextTakingsRequest struct {
 
 func (x *GetNextTakingsRequest) Reset() {
 	*x = GetNextTakingsRequest{}
-	mi := &file_schedule_proto_msgTypes[6]
+	mi := &file_schedule_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -362,7 +590,7 @@ func (x *GetNextTakingsRequest) String() string {
 func (*GetNextTakingsRequest) ProtoMessage() {}
 
 func (x *GetNextTakingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_schedule_proto_msgTypes[6]
+	mi := &file_schedule_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -375,7 +603,7 @@ func (x *GetNextTakingsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetNextTakingsRequest.ProtoReflect.Descriptor instead.
 func (*GetNextTakingsRequest) Descriptor() ([]byte, []int) {
-	return file_schedule_proto_rawDescGZIP(), []int{6}
+	return file_schedule_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetNextTakingsRequest) GetUserId() int64 {
@@ -394,7 +622,7 @@ type GetNextTakingsReply struct {
 
 func (x *GetNextTakingsReply) Reset() {
 	*x = GetNextTakingsReply{}
-	mi := &file_schedule_proto_msgTypes[7]
+	mi := &file_schedule_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -406,7 +634,7 @@ func (x *GetNextTakingsReply) String() string {
 func (*GetNextTakingsReply) ProtoMessage() {}
 
 func (x *GetNextTakingsReply) ProtoReflect() protoreflect.Message {
-	mi := &file_schedule_proto_msgTypes[7]
+	mi := &file_schedule_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -419,7 +647,7 @@ func (x *GetNextTakingsReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetNextTakingsReply.ProtoReflect.Descriptor instead.
 func (*GetNextTakingsReply) Descriptor() ([]byte, []int) {
-	return file_schedule_proto_rawDescGZIP(), []int{7}
+	return file_schedule_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GetNextTakingsReply) GetItems() []*GetNextTakingsReplyItem {
@@ -442,7 +670,7 @@ type GetNextTakingsReplyItem struct {
 
 func (x *GetNextTakingsReplyItem) Reset() {
 	*x = GetNextTakingsReplyItem{}
-	mi := &file_schedule_proto_msgTypes[8]
+	mi := &file_schedule_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -454,7 +682,7 @@ func (x *GetNextTakingsReplyItem) String() string {
 func (*GetNextTakingsReplyItem) ProtoMessage() {}
 
 func (x *GetNextTakingsReplyItem) ProtoReflect() protoreflect.Message {
-	mi := &file_schedule_proto_msgTypes[8]
+	mi := &file_schedule_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -467,7 +695,7 @@ func (x *GetNextTakingsReplyItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetNextTakingsReplyItem.ProtoReflect.Descriptor instead.
 func (*GetNextTakingsReplyItem) Descriptor() ([]byte, []int) {
-	return file_schedule_proto_rawDescGZIP(), []int{8}
+	return file_schedule_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *GetNextTakingsReplyItem) GetId() int32 {
@@ -530,7 +758,25 @@ const file_schedule_proto_rawDesc = "" +
 	"\x13GetSchedulesRequest\x12\x16\n" +
 	"\x06userId\x18\x01 \x01(\x03R\x06userId\"5\n" +
 	"\x11GetSchedulesReply\x12 \n" +
-	"\vscheduleIds\x18\x01 \x03(\x05R\vscheduleIds\"/\n" +
+	"\vscheduleIds\x18\x01 \x03(\x05R\vscheduleIds\"\x84\x02\n" +
+	"\x14ListSchedulesRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\x03R\x06userId\x12\"\n" +
+	"\fnameContains\x18\x02 \x01(\tR\fnameContains\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x1c\n" +
+	"\tendAtFrom\x18\x04 \x01(\x03R\tendAtFrom\x12\x18\n" +
+	"\aendAtTo\x18\x05 \x01(\x03R\aendAtTo\x12\x12\n" +
+	"\x04sort\x18\x06 \x01(\tR\x04sort\x12\x12\n" +
+	"\x04desc\x18\a \x01(\bR\x04desc\x12\x1a\n" +
+	"\bpageSize\x18\b \x01(\x05R\bpageSize\x12\x1c\n" +
+	"\tpageToken\x18\t \x01(\tR\tpageToken\"r\n" +
+	"\x12ListSchedulesReply\x126\n" +
+	"\x05items\x18\x01 \x03(\v2 .schedule.ListSchedulesReplyItemR\x05items\x12$\n" +
+	"\rnextPageToken\x18\x02 \x01(\tR\rnextPageToken\"j\n" +
+	"\x16ListSchedulesReplyItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05endAt\x18\x03 \x01(\x03R\x05endAt\x12\x16\n" +
+	"\x06period\x18\x04 \x01(\x03R\x06period\"/\n" +
 	"\x15GetNextTakingsRequest\x12\x16\n" +
 	"\x06userId\x18\x01 \x01(\x03R\x06userId\"N\n" +
 	"\x13GetNextTakingsReply\x127\n" +
@@ -542,11 +788,12 @@ const file_schedule_proto_rawDesc = "" +
 	"\x06period\x18\x04 \x01(\x03R\x06period\x12\x1e\n" +
 	"\n" +
 	"nextTaking\x18\x05 \x01(\x03R\n" +
-	"nextTaking2\xc3\x02\n" +
+	"nextTaking2\x92\x03\n" +
 	"\bSchedule\x12P\n" +
 	"\x0eCreateSchedule\x12\x1f.schedule.CreateScheduleRequest\x1a\x1d.schedule.CreateScheduleReply\x12G\n" +
 	"\vGetSchedule\x12\x1c.schedule.GetScheduleRequest\x1a\x1a.schedule.GetScheduleReply\x12J\n" +
-	"\fGetSchedules\x12\x1d.schedule.GetSchedulesRequest\x1a\x1b.schedule.GetSchedulesReply\x12P\n" +
+	"\fGetSchedules\x12\x1d.schedule.GetSchedulesRequest\x1a\x1b.schedule.GetSchedulesReply\x12M\n" +
+	"\rListSchedules\x12\x1e.schedule.ListSchedulesRequest\x1a\x1c.schedule.ListSchedulesReply\x12P\n" +
 	"\x0eGetNextTakings\x12\x1f.schedule.GetNextTakingsRequest\x1a\x1d.schedule.GetNextTakingsReplyB\x18Z\x16schedule.v1;schedulev1b\x06proto3"
 
 var (
@@ -561,7 +808,7 @@ func file_schedule_proto_rawDescGZIP() []byte {
 	return file_schedule_proto_rawDescData
 }
 
-var file_schedule_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_schedule_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_schedule_proto_goTypes = []any{
 	(*CreateScheduleRequest)(nil),   // 0: schedule.CreateScheduleRequest
 	(*CreateScheduleReply)(nil),     // 1: schedule.CreateScheduleReply
@@ -569,25 +816,31 @@ var file_schedule_proto_goTypes = []any{
 	(*GetScheduleReply)(nil),        // 3: schedule.GetScheduleReply
 	(*GetSchedulesRequest)(nil),     // 4: schedule.GetSchedulesRequest
 	(*GetSchedulesReply)(nil),       // 5: schedule.GetSchedulesReply
-	(*GetNextTakingsRequest)(nil),   // 6: schedule.GetNextTakingsRequest
-	(*GetNextTakingsReply)(nil),     // 7: schedule.GetNextTakingsReply
-	(*GetNextTakingsReplyItem)(nil), // 8: schedule.GetNextTakingsReplyItem
+	(*ListSchedulesRequest)(nil),    // 6: schedule.ListSchedulesRequest
+	(*ListSchedulesReply)(nil),      // 7: schedule.ListSchedulesReply
+	(*ListSchedulesReplyItem)(nil),  // 8: schedule.ListSchedulesReplyItem
+	(*GetNextTakingsRequest)(nil),   // 9: schedule.GetNextTakingsRequest
+	(*GetNextTakingsReply)(nil),     // 10: schedule.GetNextTakingsReply
+	(*GetNextTakingsReplyItem)(nil), // 11: schedule.GetNextTakingsReplyItem
 }
 var file_schedule_proto_depIdxs = []int32{
-	8, // 0: schedule.GetNextTakingsReply.items:type_name -> schedule.GetNextTakingsReplyItem
-	0, // 1: schedule.Schedule.CreateSchedule:input_type -> schedule.CreateScheduleRequest
-	2, // 2: schedule.Schedule.GetSchedule:input_type -> schedule.GetScheduleRequest
-	4, // 3: schedule.Schedule.GetSchedules:input_type -> schedule.GetSchedulesRequest
-	6, // 4: schedule.Schedule.GetNextTakings:input_type -> schedule.GetNextTakingsRequest
-	1, // 5: schedule.Schedule.CreateSchedule:output_type -> schedule.CreateScheduleReply
-	3, // 6: schedule.Schedule.GetSchedule:output_type -> schedule.GetScheduleReply
-	5, // 7: schedule.Schedule.GetSchedules:output_type -> schedule.GetSchedulesReply
-	7, // 8: schedule.Schedule.GetNextTakings:output_type -> schedule.GetNextTakingsReply
-	5, // [5:9] is the sub-list for method output_type
-	1, // [1:5] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	8,  // 0: schedule.ListSchedulesReply.items:type_name -> schedule.ListSchedulesReplyItem
+	11, // 1: schedule.GetNextTakingsReply.items:type_name -> schedule.GetNextTakingsReplyItem
+	0,  // 2: schedule.Schedule.CreateSchedule:input_type -> schedule.CreateScheduleRequest
+	2,  // 3: schedule.Schedule.GetSchedule:input_type -> schedule.GetScheduleRequest
+	4,  // 4: schedule.Schedule.GetSchedules:input_type -> schedule.GetSchedulesRequest
+	6,  // 5: schedule.Schedule.ListSchedules:input_type -> schedule.ListSchedulesRequest
+	9,  // 6: schedule.Schedule.GetNextTakings:input_type -> schedule.GetNextTakingsRequest
+	1,  // 7: schedule.Schedule.CreateSchedule:output_type -> schedule.CreateScheduleReply
+	3,  // 8: schedule.Schedule.GetSchedule:output_type -> schedule.GetScheduleReply
+	5,  // 9: schedule.Schedule.GetSchedules:output_type -> schedule.GetSchedulesReply
+	7,  // 10: schedule.Schedule.ListSchedules:output_type -> schedule.ListSchedulesReply
+	10, // 11: schedule.Schedule.GetNextTakings:output_type -> schedule.GetNextTakingsReply
+	7,  // [7:12] is the sub-list for method output_type
+	2,  // [2:7] is the sub-list for method input_type
+	2,  // [2:2] is the sub-list for extension type_name
+	2,  // [2:2] is the sub-list for extension extendee
+	0,  // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_schedule_proto_init() }
@@ -601,7 +854,7 @@ func file_schedule_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_schedule_proto_rawDesc), len(file_schedule_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -22,6 +22,7 @@ const (
 	Schedule_CreateSchedule_FullMethodName = "/schedule.Schedule/CreateSchedule"
 	Schedule_GetSchedule_FullMethodName    = "/schedule.Schedule/GetSchedule"
 	Schedule_GetSchedules_FullMethodName   = "/schedule.Schedule/GetSchedules"
+	Schedule_ListSchedules_FullMethodName  = "/schedule.Schedule/ListSchedules"
 	Schedule_GetNextTakings_FullMethodName = "/schedule.Schedule/GetNextTakings"
 )
 
@@ -32,6 +33,7 @@ type ScheduleClient interface {
 	CreateSchedule(ctx context.Context, in *CreateScheduleRequest, opts ...grpc.CallOption) (*CreateScheduleReply, error)
 	GetSchedule(ctx context.Context, in *GetScheduleRequest, opts ...grpc.CallOption) (*GetScheduleReply, error)
 	GetSchedules(ctx context.Context, in *GetSchedulesRequest, opts ...grpc.CallOption) (*GetSchedulesReply, error)
+	ListSchedules(ctx context.Context, in *ListSchedulesRequest, opts ...grpc.CallOption) (*ListSchedulesReply, error)
 	GetNextTakings(ctx context.Context, in *GetNextTakingsRequest, opts ...grpc.CallOption) (*GetNextTakingsReply, error)
 }
 
@@ -73,6 +75,16 @@ func (c *scheduleClient) GetSchedules(ctx context.Context, in *GetSchedulesReque
 	return out, nil
 }
 
+func (c *scheduleClient) ListSchedules(ctx context.Context, in *ListSchedulesRequest, opts ...grpc.CallOption) (*ListSchedulesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSchedulesReply)
+	err := c.cc.Invoke(ctx, Schedule_ListSchedules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *scheduleClient) GetNextTakings(ctx context.Context, in *GetNextTakingsRequest, opts ...grpc.CallOption) (*GetNextTakingsReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetNextTakingsReply)
@@ -90,6 +102,7 @@ type ScheduleServer interface {
 	CreateSchedule(context.Context, *CreateScheduleRequest) (*CreateScheduleReply, error)
 	GetSchedule(context.Context, *GetScheduleRequest) (*GetScheduleReply, error)
 	GetSchedules(context.Context, *GetSchedulesRequest) (*GetSchedulesReply, error)
+	ListSchedules(context.Context, *ListSchedulesRequest) (*ListSchedulesReply, error)
 	GetNextTakings(context.Context, *GetNextTakingsRequest) (*GetNextTakingsReply, error)
 	mustEmbedUnimplementedScheduleServer()
 }
@@ -110,6 +123,9 @@ func (UnimplementedScheduleServer) GetSchedule(context.Context, *GetScheduleRequ
 func (UnimplementedScheduleServer) GetSchedules(context.Context, *GetSchedulesRequest) (*GetSchedulesReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSchedules not implemented")
 }
+func (UnimplementedScheduleServer) ListSchedules(context.Context, *ListSchedulesRequest) (*ListSchedulesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSchedules not implemented")
+}
 func (UnimplementedScheduleServer) GetNextTakings(context.Context, *GetNextTakingsRequest) (*GetNextTakingsReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetNextTakings not implemented")
 }
@@ -188,6 +204,24 @@ func _Schedule_GetSchedules_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Schedule_ListSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSchedulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScheduleServer).ListSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Schedule_ListSchedules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScheduleServer).ListSchedules(ctx, req.(*ListSchedulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Schedule_GetNextTakings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetNextTakingsRequest)
 	if err := dec(in); err != nil {
@@ -225,6 +259,10 @@ var Schedule_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetSchedules",
 			Handler:    _Schedule_GetSchedules_Handler,
 		},
+		{
+			MethodName: "ListSchedules",
+			Handler:    _Schedule_ListSchedules_Handler,
+		},
 		{
 			MethodName: "GetNextTakings",
 			Handler:    _Schedule_GetNextTakings_Handler,
@@ -0,0 +1,1865 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.30.2
+// source: v2/schedule.proto
+
+package schedulev2
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ScheduleWarning int32
+
+const (
+	ScheduleWarning_SCHEDULE_WARNING_UNSPECIFIED ScheduleWarning = 0
+	// Interval between takings is shorter than 1 hour.
+	ScheduleWarning_SCHEDULE_WARNING_SHORT_INTERVAL ScheduleWarning = 1
+)
+
+// Enum value maps for ScheduleWarning.
+var (
+	ScheduleWarning_name = map[int32]string{
+		0: "SCHEDULE_WARNING_UNSPECIFIED",
+		1: "SCHEDULE_WARNING_SHORT_INTERVAL",
+	}
+	ScheduleWarning_value = map[string]int32{
+		"SCHEDULE_WARNING_UNSPECIFIED":    0,
+		"SCHEDULE_WARNING_SHORT_INTERVAL": 1,
+	}
+)
+
+func (x ScheduleWarning) Enum() *ScheduleWarning {
+	p := new(ScheduleWarning)
+	*p = x
+	return p
+}
+
+func (x ScheduleWarning) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ScheduleWarning) Descriptor() protoreflect.EnumDescriptor {
+	return file_v2_schedule_proto_enumTypes[0].Descriptor()
+}
+
+func (ScheduleWarning) Type() protoreflect.EnumType {
+	return &file_v2_schedule_proto_enumTypes[0]
+}
+
+func (x ScheduleWarning) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ScheduleWarning.Descriptor instead.
+func (ScheduleWarning) EnumDescriptor() ([]byte, []int) {
+	return file_v2_schedule_proto_rawDescGZIP(), []int{0}
+}
+
+type SlotRejectReason int32
+
+const (
+	SlotRejectReason_SLOT_REJECT_REASON_UNSPECIFIED    SlotRejectReason = 0 // slot is kept
+	SlotRejectReason_SLOT_REJECT_REASON_NIGHT          SlotRejectReason = 1
+	SlotRejectReason_SLOT_REJECT_REASON_EXPIRED        SlotRejectReason = 2
+	SlotRejectReason_SLOT_REJECT_REASON_OUTSIDE_PERIOD SlotRejectReason = 3
+	SlotRejectReason_SLOT_REJECT_REASON_ROUNDED        SlotRejectReason = 4
+	SlotRejectReason_SLOT_REJECT_REASON_PASSED         SlotRejectReason = 5
+	SlotRejectReason_SLOT_REJECT_REASON_BEFORE_ANCHOR  SlotRejectReason = 6
+)
+
+// Enum value maps for SlotRejectReason.
+var (
+	SlotRejectReason_name = map[int32]string{
+		0: "SLOT_REJECT_REASON_UNSPECIFIED",
+		1: "SLOT_REJECT_REASON_NIGHT",
+		2: "SLOT_REJECT_REASON_EXPIRED",
+		3: "SLOT_REJECT_REASON_OUTSIDE_PERIOD",
+		4: "SLOT_REJECT_REASON_ROUNDED",
+		5: "SLOT_REJECT_REASON_PASSED",
+		6: "SLOT_REJECT_REASON_BEFORE_ANCHOR",
+	}
+	SlotRejectReason_value = map[string]int32{
+		"SLOT_REJECT_REASON_UNSPECIFIED":    0,
+		"SLOT_REJECT_REASON_NIGHT":          1,
+		"SLOT_REJECT_REASON_EXPIRED":        2,
+		"SLOT_REJECT_REASON_OUTSIDE_PERIOD": 3,
+		"SLOT_REJECT_REASON_ROUNDED":        4,
+		"SLOT_REJECT_REASON_PASSED":         5,
+		"SLOT_REJECT_REASON_BEFORE_ANCHOR":  6,
+	}
+)
+
+func (x SlotRejectReason) Enum() *SlotRejectReason {
+	p := new(SlotRejectReason)
+	*p = x
+	return p
+}
+
+func (x SlotRejectReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SlotRejectReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_v2_schedule_proto_enumTypes[1].Descriptor()
+}
+
+func (SlotRejectReason) Type() protoreflect.EnumType {
+	return &file_v2_schedule_proto_enumTypes[1]
+}
+
+func (x SlotRejectReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SlotRejectReason.Descriptor instead.
+func (SlotRejectReason) EnumDescriptor() ([]byte, []int) {
+	return file_v2_schedule_proto_rawDescGZIP(), []int{1}
+}
+
+type ScheduleStatus int32
+
+const (
+	ScheduleStatus_SCHEDULE_STATUS_UNSPECIFIED ScheduleStatus = 0
+	ScheduleStatus_SCHEDULE_STATUS_ACTIVE      ScheduleStatus = 1
+	ScheduleStatus_SCHEDULE_STATUS_EXPIRED     ScheduleStatus = 2
+)
+
+// Enum value maps for ScheduleStatus.
+var (
+	ScheduleStatus_name = map[int32]string{
+		0: "SCHEDULE_STATUS_UNSPECIFIED",
+		1: "SCHEDULE_STATUS_ACTIVE",
+		2: "SCHEDULE_STATUS_EXPIRED",
+	}
+	ScheduleStatus_value = map[string]int32{
+		"SCHEDULE_STATUS_UNSPECIFIED": 0,
+		"SCHEDULE_STATUS_ACTIVE":      1,
+		"SCHEDULE_STATUS_EXPIRED":     2,
+	}
+)
+
+func (x ScheduleStatus) Enum() *ScheduleStatus {
+	p := new(ScheduleStatus)
+	*p = x
+	return p
+}
+
+func (x ScheduleStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ScheduleStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_v2_schedule_proto_enumTypes[2].Descriptor()
+}
+
+func (ScheduleStatus) Type() protoreflect.EnumType {
+	return &file_v2_schedule_proto_enumTypes[2]
+}
+
+func (x ScheduleStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ScheduleStatus.Descriptor instead.
+func (ScheduleStatus) EnumDescriptor() ([]byte, []int) {
+	return file_v2_schedule_proto_rawDescGZIP(), []int{2}
+}
+
+type ScheduleSortField int32
+
+const (
+	ScheduleSortField_SCHEDULE_SORT_FIELD_UNSPECIFIED ScheduleSortField = 0 // sort by id
+	ScheduleSortField_SCHEDULE_SORT_FIELD_ID          ScheduleSortField = 1
+	ScheduleSortField_SCHEDULE_SORT_FIELD_NAME        ScheduleSortField = 2
+	ScheduleSortField_SCHEDULE_SORT_FIELD_END_TIME    ScheduleSortField = 3
+)
+
+// Enum value maps for ScheduleSortField.
+var (
+	ScheduleSortField_name = map[int32]string{
+		0: "SCHEDULE_SORT_FIELD_UNSPECIFIED",
+		1: "SCHEDULE_SORT_FIELD_ID",
+		2: "SCHEDULE_SORT_FIELD_NAME",
+		3: "SCHEDULE_SORT_FIELD_END_TIME",
+	}
+	ScheduleSortField_value = map[string]int32{
+		"SCHEDULE_SORT_FIELD_UNSPECIFIED": 0,
+		"SCHEDULE_SORT_FIELD_ID":          1,
+		"SCHEDULE_SORT_FIELD_NAME":        2,
+		"SCHEDULE_SORT_FIELD_END_TIME":    3,
+	}
+)
+
+func (x ScheduleSortField) Enum() *ScheduleSortField {
+	p := new(ScheduleSortField)
+	*p = x
+	return p
+}
+
+func (x ScheduleSortField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ScheduleSortField) Descriptor() protoreflect.EnumDescriptor {
+	return file_v2_schedule_proto_enumTypes[3].Descriptor()
+}
+
+func (ScheduleSortField) Type() protoreflect.EnumType {
+	return &file_v2_schedule_proto_enumTypes[3]
+}
+
+func (x ScheduleSortField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ScheduleSortField.Descriptor instead.
+func (ScheduleSortField) EnumDescriptor() ([]byte, []int) {
+	return file_v2_schedule_proto_rawDescGZIP(), []int{3}
+}
+
+type Schedule struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name   string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Period *durationpb.Duration   `protobuf:"bytes,3,opt,name=period,proto3" json:"period,omitempty"`
+	// Not set if schedule has no end date.
+	EndTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// Takings for current day, filled only by GetSchedule.
+	Timetable []*timestamppb.Timestamp `protobuf:"bytes,5,rep,name=timetable,proto3" json:"timetable,omitempty"`
+	// Decisions about candidate takings, filled only by GetSchedule with explain.
+	Explanation []*SlotDecision `protobuf:"bytes,6,rep,name=explanation,proto3" json:"explanation,omitempty"`
+	// Takings continue through night.
+	RoundTheClock bool `protobuf:"varint,7,opt,name=round_the_clock,json=roundTheClock,proto3" json:"round_the_clock,omitempty"`
+	// Takings of timetable outside of day window.
+	NightSlots []*timestamppb.Timestamp `protobuf:"bytes,8,rep,name=night_slots,json=nightSlots,proto3" json:"night_slots,omitempty"`
+	// Takings are stepped from anchor. Not set if takings start at begin of each day.
+	AnchorTime *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=anchor_time,json=anchorTime,proto3" json:"anchor_time,omitempty"`
+	// Late intake moves anchor.
+	Reanchor bool `protobuf:"varint,10,opt,name=reanchor,proto3" json:"reanchor,omitempty"`
+	// Number of fixed takings of day, 0 if takings are stepped by period.
+	TimesPerDay uint32 `protobuf:"varint,11,opt,name=times_per_day,json=timesPerDay,proto3" json:"times_per_day,omitempty"`
+	// Problems of schedule which do not prevent saving it.
+	Warnings []ScheduleWarning `protobuf:"varint,12,rep,packed,name=warnings,proto3,enum=schedule.v2.ScheduleWarning" json:"warnings,omitempty"`
+	// Last taking of course limited by number of doses. Not set if course is not limited by doses.
+	FinalDoseTime *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=final_dose_time,json=finalDoseTime,proto3" json:"final_dose_time,omitempty"`
+	// Takings left to final dose. Not set if course is not limited by doses, not filled by ListSchedules.
+	RemainingDoses *uint32 `protobuf:"varint,14,opt,name=remaining_doses,json=remainingDoses,proto3,oneof" json:"remaining_doses,omitempty"`
+	// Max shift of dose when reminders are consolidated. Not set if default of service is used.
+	ConsolidationTolerance *durationpb.Duration `protobuf:"bytes,15,opt,name=consolidation_tolerance,json=consolidationTolerance,proto3" json:"consolidation_tolerance,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *Schedule) Reset() {
+	*x = Schedule{}
+	mi := &file_v2_schedule_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Schedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Schedule) ProtoMessage() {}
+
+func (x *Schedule) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_schedule_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Schedule.ProtoReflect.Descriptor instead.
+func (*Schedule) Descriptor() ([]byte, []int) {
+	return file_v2_schedule_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Schedule) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Schedule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Schedule) GetPeriod() *durationpb.Duration {
+	if x != nil {
+		return x.Period
+	}
+	return nil
+}
+
+func (x *Schedule) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *Schedule) GetTimetable() []*timestamppb.Timestamp {
+	if x != nil {
+		return x.Timetable
+	}
+	return nil
+}
+
+func (x *Schedule) GetExplanation() []*SlotDecision {
+	if x != nil {
+		return x.Explanation
+	}
+	return nil
+}
+
+func (x *Schedule) GetRoundTheClock() bool {
+	if x != nil {
+		return x.RoundTheClock
+	}
+	return false
+}
+
+func (x *Schedule) GetNightSlots() []*timestamppb.Timestamp {
+	if x != nil {
+		return x.NightSlots
+	}
+	return nil
+}
+
+func (x *Schedule) GetAnchorTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AnchorTime
+	}
+	return nil
+}
+
+func (x *Schedule) GetReanchor() bool {
+	if x != nil {
+		return x.Reanchor
+	}
+	return false
+}
+
+func (x *Schedule) GetTimesPerDay() uint32 {
+	if x != nil {
+		return x.TimesPerDay
+	}
+	return 0
+}
+
+func (x *Schedule) GetWarnings() []ScheduleWarning {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+func (x *Schedule) GetFinalDoseTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinalDoseTime
+	}
+	return nil
+}
+
+func (x *Schedule) GetRemainingDoses() uint32 {
+	if x != nil && x.RemainingDoses != nil {
+		return *x.RemainingDoses
+	}
+	return 0
+}
+
+func (x *Schedule) GetConsolidationTolerance() *durationpb.Duration {
+	if x != nil {
+		return x.ConsolidationTolerance
+	}
+	return nil
+}
+
+type SlotDecision struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ScheduleId    int32                  `protobuf:"varint,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	Time          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+	Kept          bool                   `protobuf:"varint,3,opt,name=kept,proto3" json:"kept,omitempty"`
+	Reason        SlotRejectReason       `protobuf:"varint,4,opt,name=reason,proto3,enum=schedule.v2.SlotRejectReason" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SlotDecision) Reset() {
+	*x = SlotDecision{}
+	mi := &file_v2_schedule_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SlotDecision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlotDecision) ProtoMessage() {}
+
+func (x *SlotDecision) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_schedule_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlotDecision.ProtoReflect.Descriptor instead.
+func (*SlotDecision) Descriptor() ([]byte, []int) {
+	return file_v2_schedule_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SlotDecision) GetScheduleId() int32 {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return 0
+}
+
+func (x *SlotDecision) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *SlotDecision) GetKept() bool {
+	if x != nil {
+		return x.Kept
+	}
+	return false
+}
+
+func (x *SlotDecision) GetReason() SlotRejectReason {
+	if x != nil {
+		return x.Reason
+	}
+	return SlotRejectReason_SLOT_REJECT_REASON_UNSPECIFIED
+}
+
+type CreateScheduleRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name   string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Period *durationpb.Duration   `protobuf:"bytes,3,opt,name=period,proto3" json:"period,omitempty"`
+	// Schedule length in days. Not set if schedule has no end date.
+	DurationDays *uint32 `protobuf:"varint,4,opt,name=duration_days,json=durationDays,proto3,oneof" json:"duration_days,omitempty"`
+	// Takings continue through night, counted from anchor or begin of first day.
+	RoundTheClock bool `protobuf:"varint,5,opt,name=round_the_clock,json=roundTheClock,proto3" json:"round_the_clock,omitempty"`
+	// Time of first taking, takings are stepped from it.
+	AnchorTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=anchor_time,json=anchorTime,proto3" json:"anchor_time,omitempty"`
+	// Late intake moves anchor.
+	Reanchor bool `protobuf:"varint,7,opt,name=reanchor,proto3" json:"reanchor,omitempty"`
+	// Takings are spread evenly over day instead of period, period must not be set.
+	TimesPerDay uint32 `protobuf:"varint,8,opt,name=times_per_day,json=timesPerDay,proto3" json:"times_per_day,omitempty"`
+	// Course ends with this number of takings instead of duration, duration_days must not be set.
+	Doses uint32 `protobuf:"varint,9,opt,name=doses,proto3" json:"doses,omitempty"`
+	// Max shift of dose when reminders are consolidated, limited by quarter of period, at most 6h. Default of service if not set.
+	ConsolidationTolerance *durationpb.Duration `protobuf:"bytes,10,opt,name=consolidation_tolerance,json=consolidationTolerance,proto3" json:"consolidation_tolerance,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *CreateScheduleRequest) Reset() {
+	*x = CreateScheduleRequest{}
+	mi := &file_v2_schedule_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateScheduleRequest) ProtoMessage() {}
+
+func (x *CreateScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_schedule_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateScheduleRequest.ProtoReflect.Descriptor instead.
+func (*CreateScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_v2_schedule_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateScheduleRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateScheduleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateScheduleRequest) GetPeriod() *durationpb.Duration {
+	if x != nil {
+		return x.Period
+	}
+	return nil
+}
+
+func (x *CreateScheduleRequest) GetDurationDays() uint32 {
+	if x != nil && x.DurationDays != nil {
+		return *x.DurationDays
+	}
+	return 0
+}
+
+func (x *CreateScheduleRequest) GetRoundTheClock() bool {
+	if x != nil {
+		return x.RoundTheClock
+	}
+	return false
+}
+
+func (x *CreateScheduleRequest) GetAnchorTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AnchorTime
+	}
+	return nil
+}
+
+func (x *CreateScheduleRequest) GetReanchor() bool {
+	if x != nil {
+		return x.Reanchor
+	}
+	return false
+}
+
+func (x *CreateScheduleRequest) GetTimesPerDay() uint32 {
+	if x != nil {
+		return x.TimesPerDay
+	}
+	return 0
+}
+
+func (x *CreateScheduleRequest) GetDoses() uint32 {
+	if x != nil {
+		return x.Doses
+	}
+	return 0
+}
+
+func (x *CreateScheduleRequest) GetConsolidationTolerance() *durationpb.Duration {
+	if x != nil {
+		return x.ConsolidationTolerance
+	}
+	return nil
+}
+
+type PreviewScheduleRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Schedule *CreateScheduleRequest `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	// Number of days since today, 1 if not set, at most 31.
+	Days          int32 `protobuf:"varint,2,opt,name=days,proto3" json:"days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewScheduleRequest) Reset() {
+	*x = PreviewScheduleRequest{}
+	mi := &file_v2_schedule_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewScheduleRequest) ProtoMessage() {}
+
+func (x *PreviewScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_schedule_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewScheduleRequest.ProtoReflect.Descriptor instead.
+func (*PreviewScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_v2_schedule_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PreviewScheduleRequest) GetSchedule() *CreateScheduleRequest {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+func (x *PreviewScheduleRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+type PreviewScheduleResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Id and timetable are not set.
+	Schedule      *Schedule                `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	Days          []*PreviewDay            `protobuf:"bytes,2,rep,name=days,proto3" json:"days,omitempty"`
+	NextTakings   []*timestamppb.Timestamp `protobuf:"bytes,3,rep,name=next_takings,json=nextTakings,proto3" json:"next_takings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewScheduleResponse) Reset() {
+	*x = PreviewScheduleResponse{}
+	mi := &file_v2_schedule_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewScheduleResponse) ProtoMessage() {}
+
+func (x *PreviewScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_schedule_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewScheduleResponse.ProtoReflect.Descriptor instead.
+func (*PreviewScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_v2_schedule_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PreviewScheduleResponse) GetSchedule() *Schedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+func (x *PreviewScheduleResponse) GetDays() []*PreviewDay {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+func (x *PreviewScheduleResponse) GetNextTakings() []*timestamppb.Timestamp {
+	if x != nil {
+		return x.NextTakings
+	}
+	return nil
+}
+
+type PreviewDay struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Begin of day in user timezone.
+	Date      *timestamppb.Timestamp   `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Timetable []*timestamppb.Timestamp `protobuf:"bytes,2,rep,name=timetable,proto3" json:"timetable,omitempty"`
+	// Takings of timetable outside of day window.
+	NightSlots    []*timestamppb.Timestamp `protobuf:"bytes,3,rep,name=night_slots,json=nightSlots,proto3" json:"night_slots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewDay) Reset() {
+	*x = PreviewDay{}
+	mi := &file_v2_schedule_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewDay) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewDay) ProtoMessage() {}
+
+func (x *PreviewDay) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_schedule_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewDay.ProtoReflect.Descriptor instead.
+func (*PreviewDay) Descriptor() ([]byte, []int) {
+	return file_v2_schedule_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PreviewDay) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *PreviewDay) GetTimetable() []*timestamppb.Timestamp {
+	if x != nil {
+		return x.Timetable
+	}
+	return nil
+}
+
+func (x *PreviewDay) GetNightSlots() []*timestamppb.Timestamp {
+	if x != nil {
+		return x.NightSlots
+	}
+	return nil
+}
+
+type GetScheduleRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	UserId     int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ScheduleId int32                  `protobuf:"varint,2,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	// Explain why each candidate taking is kept or rejected.
+	Explain       bool `protobuf:"varint,3,opt,name=explain,proto3" json:"explain,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetScheduleRequest) Reset() {
+	*x = GetScheduleRequest{}
+	mi := &file_v2_schedule_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScheduleRequest) ProtoMessage() {}
+
+func (x *GetScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_schedule_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScheduleRequest.ProtoReflect.Descriptor instead.
+func (*GetScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_v2_schedule_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetScheduleRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetScheduleRequest) GetScheduleId() int32 {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return 0
+}
+
+func (x *GetScheduleRequest) GetExplain() bool {
+	if x != nil {
+		return x.Explain
+	}
+	return false
+}
+
+type RecordIntakeRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	UserId     int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ScheduleId int32                  `protobuf:"varint,2,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	// Now if not set.
+	TakenTime     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=taken_time,json=takenTime,proto3" json:"taken_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordIntakeRequest) Reset() {
+	*x = RecordIntakeRequest{}
+	mi := &file_v2_schedule_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordIntakeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordIntakeRequest) ProtoMessage() {}
+
+func (x *RecordIntakeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_schedule_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordIntakeRequest.ProtoReflect.Descriptor instead.
+func (*RecordIntakeRequest) Descriptor() ([]byte, []int) {
+	return file_v2_schedule_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RecordIntakeRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RecordIntakeRequest) GetScheduleId() int32 {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return 0
+}
+
+func (x *RecordIntakeRequest) GetTakenTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TakenTime
+	}
+	return nil
+}
+
+type ListSchedulesRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	UserId   int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PageSize int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Valid only with same filters and sorting.
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	NameContains  *string                `protobuf:"bytes,4,opt,name=name_contains,json=nameContains,proto3,oneof" json:"name_contains,omitempty"`
+	Status        ScheduleStatus         `protobuf:"varint,5,opt,name=status,proto3,enum=schedule.v2.ScheduleStatus" json:"status,omitempty"`
+	EndTimeFrom   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=end_time_from,json=endTimeFrom,proto3" json:"end_time_from,omitempty"`
+	EndTimeTo     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=end_time_to,json=endTimeTo,proto3" json:"end_time_to,omitempty"`
+	SortField     ScheduleSortField      `protobuf:"varint,8,opt,name=sort_field,json=sortField,proto3,enum=schedule.v2.ScheduleSortField" json:"sort_field,omitempty"`
+	Descending    bool                   `protobuf:"varint,9,opt,name=descending,proto3" json:"descending,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSchedulesRequest) Reset() {
+	*x = ListSchedulesRequest{}
+	mi := &file_v2_schedule_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSchedulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSchedulesRequest) ProtoMessage() {}
+
+func (x *ListSchedulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_schedule_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSchedulesRequest.ProtoReflect.Descriptor instead.
+func (*ListSchedulesRequest) Descriptor() ([]byte, []int) {
+	return file_v2_schedule_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListSchedulesRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ListSchedulesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListSchedulesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListSchedulesRequest) GetNameContains() string {
+	if x != nil && x.NameContains != nil {
+		return *x.NameContains
+	}
+	return ""
+}
+
+func (x *ListSchedulesRequest) GetStatus() ScheduleStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ScheduleStatus_SCHEDULE_STATUS_UNSPECIFIED
+}
+
+func (x *ListSchedulesRequest) GetEndTimeFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTimeFrom
+	}
+	return nil
+}
+
+func (x *ListSchedulesRequest) GetEndTimeTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTimeTo
+	}
+	return nil
+}
+
+func (x *ListSchedulesRequest) GetSortField() ScheduleSortField {
+	if x != nil {
+		return x.SortField
+	}
+	return ScheduleSortField_SCHEDULE_SORT_FIELD_UNSPECIFIED
+}
+
+func (x *ListSchedulesRequest) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
+type ListSchedulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schedules     []*Schedule            `protobuf:"bytes,1,rep,name=schedules,proto3" json:"schedules,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSchedulesResponse) Reset() {
+	*x = ListSchedulesResponse{}
+	mi := &file_v2_schedule_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSchedulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSchedulesResponse) ProtoMessage() {}
+
+func (x *ListSchedulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_schedule_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSchedulesResponse.ProtoReflect.Descriptor instead.
+func (*ListSchedulesResponse) Descriptor() ([]byte, []int) {
+	return file_v2_schedule_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListSchedulesResponse) GetSchedules() []*Schedule {
+	if x != nil {
+		return x.Schedules
+	}
+	return nil
+}
+
+func (x *ListSchedulesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ListNextTakingsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Explain why each candidate taking is kept or rejected.
+	Explain       bool `protobuf:"varint,2,opt,name=explain,proto3" json:"explain,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNextTakingsRequest) Reset() {
+	*x = ListNextTakingsRequest{}
+	mi := &file_v2_schedule_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNextTakingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNextTakingsRequest) ProtoMessage() {}
+
+func (x *ListNextTakingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_schedule_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNextTakingsRequest.ProtoReflect.Descriptor instead.
+func (*ListNextTakingsRequest) Descriptor() ([]byte, []int) {
+	return file_v2_schedule_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListNextTakingsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ListNextTakingsRequest) GetExplain() bool {
+	if x != nil {
+		return x.Explain
+	}
+	return false
+}
+
+type ListNextTakingsResponse struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	NextTakings []*NextTaking          `protobuf:"bytes,1,rep,name=next_takings,json=nextTakings,proto3" json:"next_takings,omitempty"`
+	// Filled only with explain.
+	Explanation   []*SlotDecision `protobuf:"bytes,2,rep,name=explanation,proto3" json:"explanation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNextTakingsResponse) Reset() {
+	*x = ListNextTakingsResponse{}
+	mi := &file_v2_schedule_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNextTakingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNextTakingsResponse) ProtoMessage() {}
+
+func (x *ListNextTakingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_schedule_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNextTakingsResponse.ProtoReflect.Descriptor instead.
+func (*ListNextTakingsResponse) Descriptor() ([]byte, []int) {
+	return file_v2_schedule_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListNextTakingsResponse) GetNextTakings() []*NextTaking {
+	if x != nil {
+		return x.NextTakings
+	}
+	return nil
+}
+
+func (x *ListNextTakingsResponse) GetExplanation() []*SlotDecision {
+	if x != nil {
+		return x.Explanation
+	}
+	return nil
+}
+
+type NextTaking struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Schedule *Schedule              `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	Time     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+	// Taking of round-the-clock schedule outside of day window.
+	Night         bool `protobuf:"varint,3,opt,name=night,proto3" json:"night,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NextTaking) Reset() {
+	*x = NextTaking{}
+	mi := &file_v2_schedule_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NextTaking) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NextTaking) ProtoMessage() {}
+
+func (x *NextTaking) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_schedule_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NextTaking.ProtoReflect.Descriptor instead.
+func (*NextTaking) Descriptor() ([]byte, []int) {
+	return file_v2_schedule_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *NextTaking) GetSchedule() *Schedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+func (x *NextTaking) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *NextTaking) GetNight() bool {
+	if x != nil {
+		return x.Night
+	}
+	return false
+}
+
+type GetDayPlanRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Day in user timezone in YYYY-MM-DD format, today if empty.
+	Date string `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	// Preview plan with doses moved to shared slots regardless of user settings.
+	Consolidate   bool `protobuf:"varint,3,opt,name=consolidate,proto3" json:"consolidate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDayPlanRequest) Reset() {
+	*x = GetDayPlanRequest{}
+	mi := &file_v2_schedule_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDayPlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDayPlanRequest) ProtoMessage() {}
+
+func (x *GetDayPlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_schedule_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDayPlanRequest.ProtoReflect.Descriptor instead.
+func (*GetDayPlanRequest) Descriptor() ([]byte, []int) {
+	return file_v2_schedule_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetDayPlanRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetDayPlanRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *GetDayPlanRequest) GetConsolidate() bool {
+	if x != nil {
+		return x.Consolidate
+	}
+	return false
+}
+
+type DayPlan struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Begin of day in user timezone.
+	Date  *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Slots []*DayPlanSlot         `protobuf:"bytes,2,rep,name=slots,proto3" json:"slots,omitempty"`
+	// Doses are moved to shared slots.
+	Consolidated  bool `protobuf:"varint,3,opt,name=consolidated,proto3" json:"consolidated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DayPlan) Reset() {
+	*x = DayPlan{}
+	mi := &file_v2_schedule_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DayPlan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DayPlan) ProtoMessage() {}
+
+func (x *DayPlan) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_schedule_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DayPlan.ProtoReflect.Descriptor instead.
+func (*DayPlan) Descriptor() ([]byte, []int) {
+	return file_v2_schedule_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DayPlan) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *DayPlan) GetSlots() []*DayPlanSlot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+func (x *DayPlan) GetConsolidated() bool {
+	if x != nil {
+		return x.Consolidated
+	}
+	return false
+}
+
+type DayPlanSlot struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Time  *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	Doses []*DayPlanDose         `protobuf:"bytes,2,rep,name=doses,proto3" json:"doses,omitempty"`
+	// Slot of round-the-clock schedules outside of day window.
+	Night         bool `protobuf:"varint,3,opt,name=night,proto3" json:"night,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DayPlanSlot) Reset() {
+	*x = DayPlanSlot{}
+	mi := &file_v2_schedule_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DayPlanSlot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DayPlanSlot) ProtoMessage() {}
+
+func (x *DayPlanSlot) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_schedule_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DayPlanSlot.ProtoReflect.Descriptor instead.
+func (*DayPlanSlot) Descriptor() ([]byte, []int) {
+	return file_v2_schedule_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DayPlanSlot) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *DayPlanSlot) GetDoses() []*DayPlanDose {
+	if x != nil {
+		return x.Doses
+	}
+	return nil
+}
+
+func (x *DayPlanSlot) GetNight() bool {
+	if x != nil {
+		return x.Night
+	}
+	return false
+}
+
+type DayPlanDose struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ScheduleId int32                  `protobuf:"varint,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	Name       string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Time by schedule, set only if dose is moved to slot at other time.
+	PrescribedTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=prescribed_time,json=prescribedTime,proto3" json:"prescribed_time,omitempty"`
+	// Recorded intake matched to dose by nearest prescribed time, not set if dose is not taken.
+	TakenAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=taken_at,json=takenAt,proto3" json:"taken_at,omitempty"`
+	// Intake is later than prescribed time by more than late dose tolerance.
+	Late          bool `protobuf:"varint,5,opt,name=late,proto3" json:"late,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DayPlanDose) Reset() {
+	*x = DayPlanDose{}
+	mi := &file_v2_schedule_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DayPlanDose) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DayPlanDose) ProtoMessage() {}
+
+func (x *DayPlanDose) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_schedule_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DayPlanDose.ProtoReflect.Descriptor instead.
+func (*DayPlanDose) Descriptor() ([]byte, []int) {
+	return file_v2_schedule_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DayPlanDose) GetScheduleId() int32 {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return 0
+}
+
+func (x *DayPlanDose) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DayPlanDose) GetPrescribedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PrescribedTime
+	}
+	return nil
+}
+
+func (x *DayPlanDose) GetTakenAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TakenAt
+	}
+	return nil
+}
+
+func (x *DayPlanDose) GetLate() bool {
+	if x != nil {
+		return x.Late
+	}
+	return false
+}
+
+type GetUserSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserSettingsRequest) Reset() {
+	*x = GetUserSettingsRequest{}
+	mi := &file_v2_schedule_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserSettingsRequest) ProtoMessage() {}
+
+func (x *GetUserSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_schedule_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserSettingsRequest.ProtoReflect.Descriptor instead.
+func (*GetUserSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_v2_schedule_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetUserSettingsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type UserSettings struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Move doses of different schedules to shared slots within tolerance.
+	ConsolidateReminders bool `protobuf:"varint,2,opt,name=consolidate_reminders,json=consolidateReminders,proto3" json:"consolidate_reminders,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *UserSettings) Reset() {
+	*x = UserSettings{}
+	mi := &file_v2_schedule_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserSettings) ProtoMessage() {}
+
+func (x *UserSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_schedule_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserSettings.ProtoReflect.Descriptor instead.
+func (*UserSettings) Descriptor() ([]byte, []int) {
+	return file_v2_schedule_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UserSettings) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UserSettings) GetConsolidateReminders() bool {
+	if x != nil {
+		return x.ConsolidateReminders
+	}
+	return false
+}
+
+var File_v2_schedule_proto protoreflect.FileDescriptor
+
+const file_v2_schedule_proto_rawDesc = "" +
+	"\n" +
+	"\x11v2/schedule.proto\x12\vschedule.v2\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x85\x06\n" +
+	"\bSchedule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x121\n" +
+	"\x06period\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\x06period\x125\n" +
+	"\bend_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x128\n" +
+	"\ttimetable\x18\x05 \x03(\v2\x1a.google.protobuf.TimestampR\ttimetable\x12;\n" +
+	"\vexplanation\x18\x06 \x03(\v2\x19.schedule.v2.SlotDecisionR\vexplanation\x12&\n" +
+	"\x0fround_the_clock\x18\a \x01(\bR\rroundTheClock\x12;\n" +
+	"\vnight_slots\x18\b \x03(\v2\x1a.google.protobuf.TimestampR\n" +
+	"nightSlots\x12;\n" +
+	"\vanchor_time\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"anchorTime\x12\x1a\n" +
+	"\breanchor\x18\n" +
+	" \x01(\bR\breanchor\x12\"\n" +
+	"\rtimes_per_day\x18\v \x01(\rR\vtimesPerDay\x128\n" +
+	"\bwarnings\x18\f \x03(\x0e2\x1c.schedule.v2.ScheduleWarningR\bwarnings\x12B\n" +
+	"\x0ffinal_dose_time\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\rfinalDoseTime\x12,\n" +
+	"\x0fremaining_doses\x18\x0e \x01(\rH\x00R\x0eremainingDoses\x88\x01\x01\x12R\n" +
+	"\x17consolidation_tolerance\x18\x0f \x01(\v2\x19.google.protobuf.DurationR\x16consolidationToleranceB\x12\n" +
+	"\x10_remaining_doses\"\xaa\x01\n" +
+	"\fSlotDecision\x12\x1f\n" +
+	"\vschedule_id\x18\x01 \x01(\x05R\n" +
+	"scheduleId\x12.\n" +
+	"\x04time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12\x12\n" +
+	"\x04kept\x18\x03 \x01(\bR\x04kept\x125\n" +
+	"\x06reason\x18\x04 \x01(\x0e2\x1d.schedule.v2.SlotRejectReasonR\x06reason\"\xc2\x03\n" +
+	"\x15CreateScheduleRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x121\n" +
+	"\x06period\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\x06period\x12(\n" +
+	"\rduration_days\x18\x04 \x01(\rH\x00R\fdurationDays\x88\x01\x01\x12&\n" +
+	"\x0fround_the_clock\x18\x05 \x01(\bR\rroundTheClock\x12;\n" +
+	"\vanchor_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"anchorTime\x12\x1a\n" +
+	"\breanchor\x18\a \x01(\bR\breanchor\x12\"\n" +
+	"\rtimes_per_day\x18\b \x01(\rR\vtimesPerDay\x12\x14\n" +
+	"\x05doses\x18\t \x01(\rR\x05doses\x12R\n" +
+	"\x17consolidation_tolerance\x18\n" +
+	" \x01(\v2\x19.google.protobuf.DurationR\x16consolidationToleranceB\x10\n" +
+	"\x0e_duration_days\"l\n" +
+	"\x16PreviewScheduleRequest\x12>\n" +
+	"\bschedule\x18\x01 \x01(\v2\".schedule.v2.CreateScheduleRequestR\bschedule\x12\x12\n" +
+	"\x04days\x18\x02 \x01(\x05R\x04days\"\xb8\x01\n" +
+	"\x17PreviewScheduleResponse\x121\n" +
+	"\bschedule\x18\x01 \x01(\v2\x15.schedule.v2.ScheduleR\bschedule\x12+\n" +
+	"\x04days\x18\x02 \x03(\v2\x17.schedule.v2.PreviewDayR\x04days\x12=\n" +
+	"\fnext_takings\x18\x03 \x03(\v2\x1a.google.protobuf.TimestampR\vnextTakings\"\xb3\x01\n" +
+	"\n" +
+	"PreviewDay\x12.\n" +
+	"\x04date\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x128\n" +
+	"\ttimetable\x18\x02 \x03(\v2\x1a.google.protobuf.TimestampR\ttimetable\x12;\n" +
+	"\vnight_slots\x18\x03 \x03(\v2\x1a.google.protobuf.TimestampR\n" +
+	"nightSlots\"h\n" +
+	"\x12GetScheduleRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1f\n" +
+	"\vschedule_id\x18\x02 \x01(\x05R\n" +
+	"scheduleId\x12\x18\n" +
+	"\aexplain\x18\x03 \x01(\bR\aexplain\"\x8a\x01\n" +
+	"\x13RecordIntakeRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1f\n" +
+	"\vschedule_id\x18\x02 \x01(\x05R\n" +
+	"scheduleId\x129\n" +
+	"\n" +
+	"taken_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\ttakenTime\"\xb7\x03\n" +
+	"\x14ListSchedulesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12(\n" +
+	"\rname_contains\x18\x04 \x01(\tH\x00R\fnameContains\x88\x01\x01\x123\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x1b.schedule.v2.ScheduleStatusR\x06status\x12>\n" +
+	"\rend_time_from\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vendTimeFrom\x12:\n" +
+	"\vend_time_to\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tendTimeTo\x12=\n" +
+	"\n" +
+	"sort_field\x18\b \x01(\x0e2\x1e.schedule.v2.ScheduleSortFieldR\tsortField\x12\x1e\n" +
+	"\n" +
+	"descending\x18\t \x01(\bR\n" +
+	"descendingB\x10\n" +
+	"\x0e_name_contains\"t\n" +
+	"\x15ListSchedulesResponse\x123\n" +
+	"\tschedules\x18\x01 \x03(\v2\x15.schedule.v2.ScheduleR\tschedules\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"K\n" +
+	"\x16ListNextTakingsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x18\n" +
+	"\aexplain\x18\x02 \x01(\bR\aexplain\"\x92\x01\n" +
+	"\x17ListNextTakingsResponse\x12:\n" +
+	"\fnext_takings\x18\x01 \x03(\v2\x17.schedule.v2.NextTakingR\vnextTakings\x12;\n" +
+	"\vexplanation\x18\x02 \x03(\v2\x19.schedule.v2.SlotDecisionR\vexplanation\"\x85\x01\n" +
+	"\n" +
+	"NextTaking\x121\n" +
+	"\bschedule\x18\x01 \x01(\v2\x15.schedule.v2.ScheduleR\bschedule\x12.\n" +
+	"\x04time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12\x14\n" +
+	"\x05night\x18\x03 \x01(\bR\x05night\"b\n" +
+	"\x11GetDayPlanRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\x12 \n" +
+	"\vconsolidate\x18\x03 \x01(\bR\vconsolidate\"\x8d\x01\n" +
+	"\aDayPlan\x12.\n" +
+	"\x04date\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x12.\n" +
+	"\x05slots\x18\x02 \x03(\v2\x18.schedule.v2.DayPlanSlotR\x05slots\x12\"\n" +
+	"\fconsolidated\x18\x03 \x01(\bR\fconsolidated\"\x83\x01\n" +
+	"\vDayPlanSlot\x12.\n" +
+	"\x04time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12.\n" +
+	"\x05doses\x18\x02 \x03(\v2\x18.schedule.v2.DayPlanDoseR\x05doses\x12\x14\n" +
+	"\x05night\x18\x03 \x01(\bR\x05night\"\xd2\x01\n" +
+	"\vDayPlanDose\x12\x1f\n" +
+	"\vschedule_id\x18\x01 \x01(\x05R\n" +
+	"scheduleId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12C\n" +
+	"\x0fprescribed_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x0eprescribedTime\x125\n" +
+	"\btaken_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\atakenAt\x12\x12\n" +
+	"\x04late\x18\x05 \x01(\bR\x04late\"1\n" +
+	"\x16GetUserSettingsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"\\\n" +
+	"\fUserSettings\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x123\n" +
+	"\x15consolidate_reminders\x18\x02 \x01(\bR\x14consolidateReminders*X\n" +
+	"\x0fScheduleWarning\x12 \n" +
+	"\x1cSCHEDULE_WARNING_UNSPECIFIED\x10\x00\x12#\n" +
+	"\x1fSCHEDULE_WARNING_SHORT_INTERVAL\x10\x01*\x80\x02\n" +
+	"\x10SlotRejectReason\x12\"\n" +
+	"\x1eSLOT_REJECT_REASON_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18SLOT_REJECT_REASON_NIGHT\x10\x01\x12\x1e\n" +
+	"\x1aSLOT_REJECT_REASON_EXPIRED\x10\x02\x12%\n" +
+	"!SLOT_REJECT_REASON_OUTSIDE_PERIOD\x10\x03\x12\x1e\n" +
+	"\x1aSLOT_REJECT_REASON_ROUNDED\x10\x04\x12\x1d\n" +
+	"\x19SLOT_REJECT_REASON_PASSED\x10\x05\x12$\n" +
+	" SLOT_REJECT_REASON_BEFORE_ANCHOR\x10\x06*j\n" +
+	"\x0eScheduleStatus\x12\x1f\n" +
+	"\x1bSCHEDULE_STATUS_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16SCHEDULE_STATUS_ACTIVE\x10\x01\x12\x1b\n" +
+	"\x17SCHEDULE_STATUS_EXPIRED\x10\x02*\x94\x01\n" +
+	"\x11ScheduleSortField\x12#\n" +
+	"\x1fSCHEDULE_SORT_FIELD_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16SCHEDULE_SORT_FIELD_ID\x10\x01\x12\x1c\n" +
+	"\x18SCHEDULE_SORT_FIELD_NAME\x10\x02\x12 \n" +
+	"\x1cSCHEDULE_SORT_FIELD_END_TIME\x10\x032\xe5\x05\n" +
+	"\x0fScheduleService\x12K\n" +
+	"\x0eCreateSchedule\x12\".schedule.v2.CreateScheduleRequest\x1a\x15.schedule.v2.Schedule\x12\\\n" +
+	"\x0fPreviewSchedule\x12#.schedule.v2.PreviewScheduleRequest\x1a$.schedule.v2.PreviewScheduleResponse\x12E\n" +
+	"\vGetSchedule\x12\x1f.schedule.v2.GetScheduleRequest\x1a\x15.schedule.v2.Schedule\x12G\n" +
+	"\fRecordIntake\x12 .schedule.v2.RecordIntakeRequest\x1a\x15.schedule.v2.Schedule\x12V\n" +
+	"\rListSchedules\x12!.schedule.v2.ListSchedulesRequest\x1a\".schedule.v2.ListSchedulesResponse\x12\\\n" +
+	"\x0fListNextTakings\x12#.schedule.v2.ListNextTakingsRequest\x1a$.schedule.v2.ListNextTakingsResponse\x12B\n" +
+	"\n" +
+	"GetDayPlan\x12\x1e.schedule.v2.GetDayPlanRequest\x1a\x14.schedule.v2.DayPlan\x12Q\n" +
+	"\x0fGetUserSettings\x12#.schedule.v2.GetUserSettingsRequest\x1a\x19.schedule.v2.UserSettings\x12J\n" +
+	"\x12UpdateUserSettings\x12\x19.schedule.v2.UserSettings\x1a\x19.schedule.v2.UserSettingsB!Z\x1fschedule/pkg/grpc/v2;schedulev2b\x06proto3"
+
+var (
+	file_v2_schedule_proto_rawDescOnce sync.Once
+	file_v2_schedule_proto_rawDescData []byte
+)
+
+func file_v2_schedule_proto_rawDescGZIP() []byte {
+	file_v2_schedule_proto_rawDescOnce.Do(func() {
+		file_v2_schedule_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_v2_schedule_proto_rawDesc), len(file_v2_schedule_proto_rawDesc)))
+	})
+	return file_v2_schedule_proto_rawDescData
+}
+
+var file_v2_schedule_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_v2_schedule_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_v2_schedule_proto_goTypes = []any{
+	(ScheduleWarning)(0),            // 0: schedule.v2.ScheduleWarning
+	(SlotRejectReason)(0),           // 1: schedule.v2.SlotRejectReason
+	(ScheduleStatus)(0),             // 2: schedule.v2.ScheduleStatus
+	(ScheduleSortField)(0),          // 3: schedule.v2.ScheduleSortField
+	(*Schedule)(nil),                // 4: schedule.v2.Schedule
+	(*SlotDecision)(nil),            // 5: schedule.v2.SlotDecision
+	(*CreateScheduleRequest)(nil),   // 6: schedule.v2.CreateScheduleRequest
+	(*PreviewScheduleRequest)(nil),  // 7: schedule.v2.PreviewScheduleRequest
+	(*PreviewScheduleResponse)(nil), // 8: schedule.v2.PreviewScheduleResponse
+	(*PreviewDay)(nil),              // 9: schedule.v2.PreviewDay
+	(*GetScheduleRequest)(nil),      // 10: schedule.v2.GetScheduleRequest
+	(*RecordIntakeRequest)(nil),     // 11: schedule.v2.RecordIntakeRequest
+	(*ListSchedulesRequest)(nil),    // 12: schedule.v2.ListSchedulesRequest
+	(*ListSchedulesResponse)(nil),   // 13: schedule.v2.ListSchedulesResponse
+	(*ListNextTakingsRequest)(nil),  // 14: schedule.v2.ListNextTakingsRequest
+	(*ListNextTakingsResponse)(nil), // 15: schedule.v2.ListNextTakingsResponse
+	(*NextTaking)(nil),              // 16: schedule.v2.NextTaking
+	(*GetDayPlanRequest)(nil),       // 17: schedule.v2.GetDayPlanRequest
+	(*DayPlan)(nil),                 // 18: schedule.v2.DayPlan
+	(*DayPlanSlot)(nil),             // 19: schedule.v2.DayPlanSlot
+	(*DayPlanDose)(nil),             // 20: schedule.v2.DayPlanDose
+	(*GetUserSettingsRequest)(nil),  // 21: schedule.v2.GetUserSettingsRequest
+	(*UserSettings)(nil),            // 22: schedule.v2.UserSettings
+	(*durationpb.Duration)(nil),     // 23: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil),   // 24: google.protobuf.Timestamp
+}
+var file_v2_schedule_proto_depIdxs = []int32{
+	23, // 0: schedule.v2.Schedule.period:type_name -> google.protobuf.Duration
+	24, // 1: schedule.v2.Schedule.end_time:type_name -> google.protobuf.Timestamp
+	24, // 2: schedule.v2.Schedule.timetable:type_name -> google.protobuf.Timestamp
+	5,  // 3: schedule.v2.Schedule.explanation:type_name -> schedule.v2.SlotDecision
+	24, // 4: schedule.v2.Schedule.night_slots:type_name -> google.protobuf.Timestamp
+	24, // 5: schedule.v2.Schedule.anchor_time:type_name -> google.protobuf.Timestamp
+	0,  // 6: schedule.v2.Schedule.warnings:type_name -> schedule.v2.ScheduleWarning
+	24, // 7: schedule.v2.Schedule.final_dose_time:type_name -> google.protobuf.Timestamp
+	23, // 8: schedule.v2.Schedule.consolidation_tolerance:type_name -> google.protobuf.Duration
+	24, // 9: schedule.v2.SlotDecision.time:type_name -> google.protobuf.Timestamp
+	1,  // 10: schedule.v2.SlotDecision.reason:type_name -> schedule.v2.SlotRejectReason
+	23, // 11: schedule.v2.CreateScheduleRequest.period:type_name -> google.protobuf.Duration
+	24, // 12: schedule.v2.CreateScheduleRequest.anchor_time:type_name -> google.protobuf.Timestamp
+	23, // 13: schedule.v2.CreateScheduleRequest.consolidation_tolerance:type_name -> google.protobuf.Duration
+	6,  // 14: schedule.v2.PreviewScheduleRequest.schedule:type_name -> schedule.v2.CreateScheduleRequest
+	4,  // 15: schedule.v2.PreviewScheduleResponse.schedule:type_name -> schedule.v2.Schedule
+	9,  // 16: schedule.v2.PreviewScheduleResponse.days:type_name -> schedule.v2.PreviewDay
+	24, // 17: schedule.v2.PreviewScheduleResponse.next_takings:type_name -> google.protobuf.Timestamp
+	24, // 18: schedule.v2.PreviewDay.date:type_name -> google.protobuf.Timestamp
+	24, // 19: schedule.v2.PreviewDay.timetable:type_name -> google.protobuf.Timestamp
+	24, // 20: schedule.v2.PreviewDay.night_slots:type_name -> google.protobuf.Timestamp
+	24, // 21: schedule.v2.RecordIntakeRequest.taken_time:type_name -> google.protobuf.Timestamp
+	2,  // 22: schedule.v2.ListSchedulesRequest.status:type_name -> schedule.v2.ScheduleStatus
+	24, // 23: schedule.v2.ListSchedulesRequest.end_time_from:type_name -> google.protobuf.Timestamp
+	24, // 24: schedule.v2.ListSchedulesRequest.end_time_to:type_name -> google.protobuf.Timestamp
+	3,  // 25: schedule.v2.ListSchedulesRequest.sort_field:type_name -> schedule.v2.ScheduleSortField
+	4,  // 26: schedule.v2.ListSchedulesResponse.schedules:type_name -> schedule.v2.Schedule
+	16, // 27: schedule.v2.ListNextTakingsResponse.next_takings:type_name -> schedule.v2.NextTaking
+	5,  // 28: schedule.v2.ListNextTakingsResponse.explanation:type_name -> schedule.v2.SlotDecision
+	4,  // 29: schedule.v2.NextTaking.schedule:type_name -> schedule.v2.Schedule
+	24, // 30: schedule.v2.NextTaking.time:type_name -> google.protobuf.Timestamp
+	24, // 31: schedule.v2.DayPlan.date:type_name -> google.protobuf.Timestamp
+	19, // 32: schedule.v2.DayPlan.slots:type_name -> schedule.v2.DayPlanSlot
+	24, // 33: schedule.v2.DayPlanSlot.time:type_name -> google.protobuf.Timestamp
+	20, // 34: schedule.v2.DayPlanSlot.doses:type_name -> schedule.v2.DayPlanDose
+	24, // 35: schedule.v2.DayPlanDose.prescribed_time:type_name -> google.protobuf.Timestamp
+	24, // 36: schedule.v2.DayPlanDose.taken_at:type_name -> google.protobuf.Timestamp
+	6,  // 37: schedule.v2.ScheduleService.CreateSchedule:input_type -> schedule.v2.CreateScheduleRequest
+	7,  // 38: schedule.v2.ScheduleService.PreviewSchedule:input_type -> schedule.v2.PreviewScheduleRequest
+	10, // 39: schedule.v2.ScheduleService.GetSchedule:input_type -> schedule.v2.GetScheduleRequest
+	11, // 40: schedule.v2.ScheduleService.RecordIntake:input_type -> schedule.v2.RecordIntakeRequest
+	12, // 41: schedule.v2.ScheduleService.ListSchedules:input_type -> schedule.v2.ListSchedulesRequest
+	14, // 42: schedule.v2.ScheduleService.ListNextTakings:input_type -> schedule.v2.ListNextTakingsRequest
+	17, // 43: schedule.v2.ScheduleService.GetDayPlan:input_type -> schedule.v2.GetDayPlanRequest
+	21, // 44: schedule.v2.ScheduleService.GetUserSettings:input_type -> schedule.v2.GetUserSettingsRequest
+	22, // 45: schedule.v2.ScheduleService.UpdateUserSettings:input_type -> schedule.v2.UserSettings
+	4,  // 46: schedule.v2.ScheduleService.CreateSchedule:output_type -> schedule.v2.Schedule
+	8,  // 47: schedule.v2.ScheduleService.PreviewSchedule:output_type -> schedule.v2.PreviewScheduleResponse
+	4,  // 48: schedule.v2.ScheduleService.GetSchedule:output_type -> schedule.v2.Schedule
+	4,  // 49: schedule.v2.ScheduleService.RecordIntake:output_type -> schedule.v2.Schedule
+	13, // 50: schedule.v2.ScheduleService.ListSchedules:output_type -> schedule.v2.ListSchedulesResponse
+	15, // 51: schedule.v2.ScheduleService.ListNextTakings:output_type -> schedule.v2.ListNextTakingsResponse
+	18, // 52: schedule.v2.ScheduleService.GetDayPlan:output_type -> schedule.v2.DayPlan
+	22, // 53: schedule.v2.ScheduleService.GetUserSettings:output_type -> schedule.v2.UserSettings
+	22, // 54: schedule.v2.ScheduleService.UpdateUserSettings:output_type -> schedule.v2.UserSettings
+	46, // [46:55] is the sub-list for method output_type
+	37, // [37:46] is the sub-list for method input_type
+	37, // [37:37] is the sub-list for extension type_name
+	37, // [37:37] is the sub-list for extension extendee
+	0,  // [0:37] is the sub-list for field type_name
+}
+
+func init() { file_v2_schedule_proto_init() }
+func file_v2_schedule_proto_init() {
+	if File_v2_schedule_proto != nil {
+		return
+	}
+	file_v2_schedule_proto_msgTypes[0].OneofWrappers = []any{}
+	file_v2_schedule_proto_msgTypes[2].OneofWrappers = []any{}
+	file_v2_schedule_proto_msgTypes[8].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_v2_schedule_proto_rawDesc), len(file_v2_schedule_proto_rawDesc)),
+			NumEnums:      4,
+			NumMessages:   19,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_v2_schedule_proto_goTypes,
+		DependencyIndexes: file_v2_schedule_proto_depIdxs,
+		EnumInfos:         file_v2_schedule_proto_enumTypes,
+		MessageInfos:      file_v2_schedule_proto_msgTypes,
+	}.Build()
+	File_v2_schedule_proto = out.File
+	file_v2_schedule_proto_goTypes = nil
+	file_v2_schedule_proto_depIdxs = nil
+}
@@ -0,0 +1,28 @@
+package interceptorx
+
+import (
+	"context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"schedule/pkg/contextx"
+)
+
+const actorMDKey = "X-Actor"
+
+// ActorUnaryInterceptor takes actor from client identity, so it must be used after ClientIdentityUnaryInterceptor.
+// X-Actor metadata is not verified, it is kept only as claimed actor.
+func ActorUnaryInterceptor(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+	if identity := contextx.GetClientIdentity(ctx); identity != nil && identity.Name() != "" {
+		ctx = contextx.WithActor(ctx, contextx.Actor(identity.Name()))
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if ok {
+		actor := md.Get(actorMDKey)
+		if len(actor) > 0 && actor[0] != "" {
+			ctx = contextx.WithClaimedActor(ctx, contextx.ClaimedActor(actor[0]))
+		}
+	}
+
+	return handler(ctx, req)
+}
@@ -0,0 +1,23 @@
+package interceptorx
+
+import (
+	"context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"schedule/pkg/contextx"
+	"schedule/pkg/tlsx"
+)
+
+// ClientIdentityUnaryInterceptor puts identity of verified client certificate to context.
+func ClientIdentityUnaryInterceptor(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+	if p, ok := peer.FromContext(ctx); ok {
+		if tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo); ok {
+			if identity := tlsx.ClientIdentity(&tlsInfo.State); identity != nil {
+				ctx = contextx.WithClientIdentity(ctx, identity)
+			}
+		}
+	}
+
+	return handler(ctx, req)
+}
@@ -0,0 +1,36 @@
+package interceptorx
+
+import (
+	"context"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+// NewMetricsUnaryInterceptor counts calls and observes latency by full method name.
+func NewMetricsUnaryInterceptor(reg prometheus.Registerer) grpc.UnaryServerInterceptor {
+	requests := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "grpc_server_handled_total",
+		Help: "Number of handled grpc calls.",
+	}, []string{"method", "code"})
+
+	duration := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "grpc_server_handling_seconds",
+		Help:    "Duration of grpc calls handling.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"method"})
+
+	reg.MustRegister(requests, duration)
+
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		elapsed := time.Since(start)
+
+		requests.WithLabelValues(info.FullMethod, status.Code(err).String()).Inc()
+		duration.WithLabelValues(info.FullMethod).Observe(elapsed.Seconds())
+
+		return resp, err
+	}
+}
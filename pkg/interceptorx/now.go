@@ -0,0 +1,31 @@
+package interceptorx
+
+import (
+	"context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"log/slog"
+	"schedule/pkg/contextx"
+	"time"
+)
+
+const debugNowMDKey = "X-Debug-Now"
+
+// OverrideNowUnaryInterceptor sets current time of call from X-Debug-Now metadata in RFC 3339 format.
+// It must be used only when debug time override is enabled in config.
+func OverrideNowUnaryInterceptor(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if ok {
+		if v := md.Get(debugNowMDKey); len(v) > 0 && v[0] != "" {
+			now, err := time.Parse(time.RFC3339, v[0])
+			if err != nil {
+				l := contextx.GetLoggerOrDefault(ctx)
+				l.WarnContext(ctx, "failed parsing debug now", slog.String("err", err.Error()), slog.String("now", v[0]))
+			} else {
+				ctx = contextx.WithNowOverride(ctx, now)
+			}
+		}
+	}
+
+	return handler(ctx, req)
+}
@@ -0,0 +1,92 @@
+package interceptorx
+
+import (
+	"context"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"log/slog"
+	"net"
+	"schedule/pkg/contextx"
+	"schedule/pkg/errcodes"
+	"schedule/pkg/ratelimit"
+	"strconv"
+)
+
+const (
+	retryAfterMdKey = "Retry-After"
+	apiKeyMdKey     = "x-api-key"
+)
+
+type userIdGetter interface {
+	GetUserId() int64
+}
+
+// NewRateLimitUnaryInterceptor limits calls by key which keyer builds from client certificate, x-api-key metadata, user id of request and peer ip.
+// Client certificate is used only if it is verified, so it must be put to context by ClientIdentityUnaryInterceptor before.
+// Calls are allowed if limiter store fails.
+func NewRateLimitUnaryInterceptor(limiter *ratelimit.Limiter, keyer *ratelimit.Keyer) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		res, err := limiter.Allow(ctx, keyer.Key(rateLimitCaller(ctx, req)))
+		if err != nil {
+			l := contextx.GetLoggerOrDefault(ctx)
+			l.LogAttrs(ctx, slog.LevelWarn, "rate limiter error", slog.String("err", err.Error()))
+			return handler(ctx, req)
+		}
+
+		if !res.Allowed {
+			retryAfter := ratelimit.RetryAfterSeconds(res.RetryAfter)
+			grpc.SetHeader(ctx, metadata.Pairs(retryAfterMdKey, strconv.Itoa(retryAfter)))
+			return nil, newRateLimitedError(ctx, res)
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+func rateLimitCaller(ctx context.Context, req any) ratelimit.Caller {
+	var c ratelimit.Caller
+	if identity := contextx.GetClientIdentity(ctx); identity != nil {
+		c.ClientName = identity.Name()
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if v := md.Get(apiKeyMdKey); len(v) > 0 {
+			c.APIKey = v[0]
+		}
+	}
+	if r, ok := req.(userIdGetter); ok && r.GetUserId() != 0 {
+		c.UserId = strconv.FormatInt(r.GetUserId(), 10)
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		host, _, err := net.SplitHostPort(p.Addr.String())
+		if err != nil {
+			host = p.Addr.String()
+		}
+		c.IP = host
+	}
+	return c
+}
+
+func newRateLimitedError(ctx context.Context, res ratelimit.Result) error {
+	st := status.New(codes.ResourceExhausted, "too many requests, retry later")
+
+	errorInfo := &errdetails.ErrorInfo{
+		Reason: errcodes.RateLimited.String(),
+		Domain: errcodes.Domain,
+	}
+	if traceId := contextx.GetTraceId(ctx); traceId != "" {
+		errorInfo.Metadata = map[string]string{"trace_id": string(traceId)}
+	}
+
+	withDetails, err := st.WithDetails(errorInfo, &errdetails.RetryInfo{
+		RetryDelay: durationpb.New(res.RetryAfter),
+	})
+	if err != nil {
+		return st.Err()
+	}
+	return withDetails.Err()
+}
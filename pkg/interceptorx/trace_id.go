@@ -2,34 +2,84 @@ package interceptorx
 
 import (
 	"context"
-	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 	"schedule/pkg/contextx"
 )
 
 const traceIdMdKey = "X-Trace-Id"
 
-func TraceIdUnaryInterceptor(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
-	var traceId string
+var tracer = otel.Tracer("schedule/pkg/interceptorx")
 
-	md, ok := metadata.FromIncomingContext(ctx)
-	if ok {
-		traceIdSlice := md.Get(traceIdMdKey)
-		if len(traceIdSlice) > 0 {
-			if traceIdSlice[0] != "" {
-				traceId = traceIdSlice[0]
-			}
+// TraceIdUnaryInterceptor continues trace from W3C traceparent metadata or starts new one, and starts span of call.
+// Without traceparent X-Trace-Id of legacy client is used as trace id of call, it is also kept as span attribute.
+// Trace id is returned in traceparent and X-Trace-Id header metadata.
+func TraceIdUnaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+	propagator := otel.GetTextMapPropagator()
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	ctx = propagator.Extract(ctx, metadataCarrier(md))
+	hasParent := trace.SpanContextFromContext(ctx).IsValid()
+
+	ctx, span := tracer.Start(ctx, info.FullMethod,
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(
+			attribute.String("rpc.system", "grpc"),
+			attribute.String("rpc.method", info.FullMethod),
+		),
+	)
+	defer span.End()
+
+	traceId := span.SpanContext().TraceID().String()
+	if clientTraceId := md.Get(traceIdMdKey); len(clientTraceId) > 0 && clientTraceId[0] != "" { // legacy clients
+		span.SetAttributes(attribute.String("client.trace_id", clientTraceId[0]))
+		if !hasParent {
+			traceId = clientTraceId[0]
 		}
 	}
 
-	if traceId == "" {
-		traceId = uuid.NewString()
-		header := metadata.Pairs(traceIdMdKey, traceId)
-		grpc.SetHeader(ctx, header)
-	}
+	header := metadata.Pairs(traceIdMdKey, traceId)
+	propagator.Inject(ctx, metadataCarrier(header))
+	grpc.SetHeader(ctx, header)
 
 	ctx = contextx.WithTraceId(ctx, contextx.TraceId(traceId))
 
-	return handler(ctx, req)
+	resp, err = handler(ctx, req)
+
+	code := status.Code(err)
+	span.SetAttributes(attribute.Int("rpc.grpc.status_code", int(code)))
+	switch code { // client errors are not errors of server span
+	case codes.Unknown, codes.DeadlineExceeded, codes.Unimplemented, codes.Internal, codes.Unavailable, codes.DataLoss:
+		span.SetStatus(otelcodes.Error, code.String())
+	}
+
+	return resp, err
+}
+
+// metadataCarrier adapts grpc metadata to propagation.TextMapCarrier.
+type metadataCarrier metadata.MD
+
+func (c metadataCarrier) Get(key string) string {
+	if v := metadata.MD(c).Get(key); len(v) > 0 {
+		return v[0]
+	}
+	return ""
+}
+
+func (c metadataCarrier) Set(key, value string) {
+	metadata.MD(c).Set(key, value)
+}
+
+func (c metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for k := range c {
+		keys = append(keys, k)
+	}
+	return keys
 }
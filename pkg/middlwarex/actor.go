@@ -0,0 +1,25 @@
+package middlwarex
+
+import (
+	"net/http"
+	"schedule/pkg/contextx"
+)
+
+const headerActor = "X-Actor"
+
+// AddActor takes actor from client identity, so it must be used after AddClientIdentity.
+// X-Actor header is not verified, it is kept only as claimed actor.
+func AddActor(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		if identity := contextx.GetClientIdentity(ctx); identity != nil && identity.Name() != "" {
+			ctx = contextx.WithActor(ctx, contextx.Actor(identity.Name()))
+		}
+		if actor := r.Header.Get(headerActor); actor != "" {
+			ctx = contextx.WithClaimedActor(ctx, contextx.ClaimedActor(actor))
+		}
+
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
@@ -0,0 +1,36 @@
+package middlwarex
+
+import (
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"schedule/pkg/contextx"
+	"testing"
+)
+
+func TestAddActor(t *testing.T) {
+	var (
+		actor   contextx.Actor
+		claimed contextx.ClaimedActor
+	)
+	handler := AddActor(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		actor = contextx.GetActorOrDefault(r.Context())
+		claimed = contextx.GetClaimedActor(r.Context())
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("X-Actor", "doctor:42")
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+	require.Equal(t, contextx.AnonymousActor, actor, "header is not authentication")
+	require.Equal(t, contextx.ClaimedActor("doctor:42"), claimed)
+
+	req = req.WithContext(contextx.WithClientIdentity(req.Context(), &contextx.ClientIdentity{URIs: []string{"spiffe://hospital/emr"}}))
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+	require.Equal(t, contextx.Actor("spiffe://hospital/emr"), actor)
+	require.Equal(t, contextx.ClaimedActor("doctor:42"), claimed)
+
+	req = httptest.NewRequest(http.MethodGet, "/", nil)
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+	require.Equal(t, contextx.AnonymousActor, actor)
+	require.Empty(t, claimed)
+}
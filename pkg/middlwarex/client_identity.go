@@ -0,0 +1,20 @@
+package middlwarex
+
+import (
+	"net/http"
+	"schedule/pkg/contextx"
+	"schedule/pkg/tlsx"
+)
+
+// AddClientIdentity puts identity of verified client certificate to context.
+func AddClientIdentity(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		if identity := tlsx.ClientIdentity(r.TLS); identity != nil {
+			ctx = contextx.WithClientIdentity(ctx, identity)
+		}
+
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
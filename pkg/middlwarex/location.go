@@ -27,7 +27,7 @@ func WithLocation(next http.Handler) http.Handler {
 		}
 
 		if loc == nil {
-			loc = time.Local
+			loc = contextx.DefaultLocation
 		}
 
 		ctx = contextx.WithLocation(r.Context(), loc)
@@ -20,6 +20,7 @@ type LogOptions struct {
 	MaxContentLen   int // < 0 log full content; == 0 not log
 	SensitiveFields []string
 	LoggingContent  []string
+	SkipPaths       []string // requests are not logged, e.g. probes
 }
 
 func NewLogRequest(opts *LogOptions) func(http.Handler) http.Handler {
@@ -29,7 +30,9 @@ func NewLogRequest(opts *LogOptions) func(http.Handler) http.Handler {
 
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			logRequest(r, opts)
+			if !slices.Contains(opts.SkipPaths, r.URL.Path) {
+				logRequest(r, opts)
+			}
 			next.ServeHTTP(w, r)
 		})
 	}
@@ -42,6 +45,11 @@ func NewLogResponse(opts *LogOptions) func(http.Handler) http.Handler {
 
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if slices.Contains(opts.SkipPaths, r.URL.Path) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
 			customWriter := &LoggingWriter{
 				ResponseWriter: w,
 				StatusCode:     http.StatusOK,
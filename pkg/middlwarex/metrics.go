@@ -0,0 +1,48 @@
+package middlwarex
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// NewMetrics counts requests and observes latency by route template, so path parameters do not blow up label cardinality.
+func NewMetrics(reg prometheus.Registerer) func(next http.Handler) http.Handler {
+	requests := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "http_requests_total",
+		Help: "Number of handled http requests.",
+	}, []string{"method", "route", "code"})
+
+	duration := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "http_request_duration_seconds",
+		Help:    "Duration of http requests handling.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"method", "route"})
+
+	reg.MustRegister(requests, duration)
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			sw := &statusWriter{ResponseWriter: w, statusCode: http.StatusOK}
+
+			start := time.Now()
+			next.ServeHTTP(sw, r)
+			elapsed := time.Since(start)
+
+			route := routeTemplate(r)
+			requests.WithLabelValues(r.Method, route, strconv.Itoa(sw.statusCode)).Inc()
+			duration.WithLabelValues(r.Method, route).Observe(elapsed.Seconds())
+		})
+	}
+}
+
+type statusWriter struct {
+	http.ResponseWriter
+	statusCode int
+}
+
+func (w *statusWriter) WriteHeader(statusCode int) {
+	w.statusCode = statusCode
+	w.ResponseWriter.WriteHeader(statusCode)
+}
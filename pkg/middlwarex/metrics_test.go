@@ -0,0 +1,37 @@
+package middlwarex
+
+import (
+	"github.com/gorilla/mux"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestMetrics(t *testing.T) {
+	reg := prometheus.NewRegistry()
+
+	rtr := mux.NewRouter()
+	rtr.Use(NewMetrics(reg))
+	rtr.HandleFunc("/users/{userId}/schedules/{id}", func(w http.ResponseWriter, r *http.Request) {
+		if mux.Vars(r)["id"] == "0" {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	for _, path := range []string{"/users/1/schedules/1", "/users/2/schedules/5", "/users/1/schedules/0"} {
+		rtr.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	}
+
+	expected := `
+# HELP http_requests_total Number of handled http requests.
+# TYPE http_requests_total counter
+http_requests_total{code="200",method="GET",route="/users/{userId}/schedules/{id}"} 2
+http_requests_total{code="404",method="GET",route="/users/{userId}/schedules/{id}"} 1
+`
+	require.NoError(t, testutil.GatherAndCompare(reg, strings.NewReader(expected), "http_requests_total"))
+	require.Equal(t, 1, testutil.CollectAndCount(reg, "http_request_duration_seconds"))
+}
@@ -0,0 +1,30 @@
+package middlwarex
+
+import (
+	"log/slog"
+	"net/http"
+	"schedule/pkg/contextx"
+	"time"
+)
+
+const headerDebugNow = "X-Debug-Now"
+
+// OverrideNow sets current time of request from X-Debug-Now header in RFC 3339 format.
+// It must be used only when debug time override is enabled in config.
+func OverrideNow(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		if v := r.Header.Get(headerDebugNow); v != "" {
+			now, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				l := contextx.GetLoggerOrDefault(ctx)
+				l.WarnContext(ctx, "failed parsing debug now", slog.String("err", err.Error()), slog.String("now", v))
+			} else {
+				ctx = contextx.WithNowOverride(ctx, now)
+			}
+		}
+
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
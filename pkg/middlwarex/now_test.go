@@ -0,0 +1,51 @@
+package middlwarex
+
+import (
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"schedule/pkg/contextx"
+	"testing"
+	"time"
+)
+
+func TestOverrideNow(t *testing.T) {
+	var (
+		handledNow time.Time
+		overridden bool
+	)
+
+	handler := OverrideNow(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handledNow, overridden = contextx.GetNowOverride(r.Context())
+	}))
+
+	cases := []struct {
+		Name       string
+		Header     string
+		Overridden bool
+		Now        time.Time
+	}{
+		{Name: "no header"},
+		{Name: "invalid header", Header: "2025-01-01 12:00"},
+		{
+			Name:       "valid header",
+			Header:     "2025-01-01T12:00:00+03:00",
+			Overridden: true,
+			Now:        time.Date(2025, time.January, 1, 9, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if c.Header != "" {
+				req.Header.Set(headerDebugNow, c.Header)
+			}
+
+			handler.ServeHTTP(httptest.NewRecorder(), req)
+
+			require.Equal(t, c.Overridden, overridden)
+			require.True(t, c.Now.Equal(handledNow))
+		})
+	}
+}
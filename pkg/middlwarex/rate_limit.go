@@ -0,0 +1,95 @@
+package middlwarex
+
+import (
+	"encoding/json"
+	"github.com/gorilla/mux"
+	"log/slog"
+	"net"
+	"net/http"
+	"schedule/internal/util"
+	"schedule/pkg/contextx"
+	"schedule/pkg/errcodes"
+	"schedule/pkg/ratelimit"
+	"schedule/pkg/rest"
+	"slices"
+	"strconv"
+)
+
+const apiKeyHeader = "X-Api-Key"
+
+// NewRateLimit limits requests by key which keyer builds from client certificate, X-Api-Key header, user id and client ip.
+// Client certificate is used only if it is verified, so it must be put to context by AddClientIdentity before.
+// Requests are allowed if limiter store fails. Skipped paths (e.g. probes) are not limited.
+func NewRateLimit(limiter *ratelimit.Limiter, keyer *ratelimit.Keyer, skipPaths ...string) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+
+			if slices.Contains(skipPaths, r.URL.Path) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			res, err := limiter.Allow(ctx, keyer.Key(rateLimitCaller(r)))
+			if err != nil {
+				l := contextx.GetLoggerOrDefault(ctx)
+				l.LogAttrs(ctx, slog.LevelWarn, "rate limiter error", slog.String("err", err.Error()))
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if !res.Allowed {
+				writeRateLimited(w, r, res)
+				return
+			}
+
+			w.Header().Set("X-RateLimit-Remaining", strconv.Itoa(res.Remaining))
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func rateLimitCaller(r *http.Request) ratelimit.Caller {
+	c := ratelimit.Caller{
+		APIKey: r.Header.Get(apiKeyHeader),
+		UserId: mux.Vars(r)["userId"],
+	}
+	if identity := contextx.GetClientIdentity(r.Context()); identity != nil {
+		c.ClientName = identity.Name()
+	}
+	if c.UserId == "" {
+		c.UserId = r.URL.Query().Get("user_id")
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	c.IP = host
+
+	return c
+}
+
+func writeRateLimited(w http.ResponseWriter, r *http.Request, res ratelimit.Result) {
+	ctx := r.Context()
+
+	problem := rest.ErrorResponse{
+		Type:   "about:blank",
+		Title:  http.StatusText(http.StatusTooManyRequests),
+		Status: http.StatusTooManyRequests,
+		Error:  errcodes.RateLimited.String(),
+		Reason: errcodes.RateLimited.String(),
+		Detail: util.Ptr("too many requests, retry later"),
+	}
+	if traceId := contextx.GetTraceId(ctx); traceId != "" {
+		problem.TraceId = util.Ptr(string(traceId))
+	}
+
+	w.Header().Set("Retry-After", strconv.Itoa(ratelimit.RetryAfterSeconds(res.RetryAfter)))
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(problem.Status)
+	if err := json.NewEncoder(w).Encode(problem); err != nil {
+		l := contextx.GetLoggerOrDefault(ctx)
+		l.LogAttrs(ctx, slog.LevelError, "json encode error", slog.String("err", err.Error()))
+	}
+}
@@ -0,0 +1,60 @@
+package middlwarex
+
+import (
+	"encoding/json"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"schedule/pkg/contextx"
+	"schedule/pkg/errcodes"
+	"schedule/pkg/ratelimit"
+	"schedule/pkg/rest"
+	"testing"
+)
+
+func TestRateLimit(t *testing.T) {
+	limiter := ratelimit.NewLimiter(ratelimit.NewMemoryStore(), ratelimit.Limit{Rate: 0.5, Burst: 1})
+	keyer, err := ratelimit.NewKeyer(ratelimit.KeyUser, []string{"secret"})
+	require.NoError(t, err)
+
+	rtr := mux.NewRouter()
+	rtr.Use(NewRateLimit(limiter, keyer, "/healthz"))
+	rtr.HandleFunc("/users/{userId}/next-takings", func(http.ResponseWriter, *http.Request) {})
+	rtr.HandleFunc("/healthz", func(http.ResponseWriter, *http.Request) {})
+
+	do := func(path string, header http.Header) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		for k, v := range header {
+			req.Header[k] = v
+		}
+		rec := httptest.NewRecorder()
+		rtr.ServeHTTP(rec, req)
+		return rec
+	}
+
+	require.Equal(t, http.StatusOK, do("/users/1/next-takings", nil).Code)
+
+	rec := do("/users/1/next-takings", nil)
+	require.Equal(t, http.StatusTooManyRequests, rec.Code)
+	require.Equal(t, "2", rec.Header().Get("Retry-After"))
+
+	var problem rest.ErrorResponse
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&problem))
+	require.Equal(t, errcodes.RateLimited.String(), problem.Reason)
+
+	require.Equal(t, http.StatusTooManyRequests, do("/users/2/next-takings", nil).Code, "unauthenticated user is limited by ip")
+	require.Equal(t, http.StatusTooManyRequests, do("/users/1/next-takings", http.Header{"X-Api-Key": {"random"}}).Code, "unknown api key is ignored")
+	require.Equal(t, http.StatusOK, do("/users/1/next-takings", http.Header{"X-Api-Key": {"secret"}}).Code, "api key")
+	require.Equal(t, http.StatusOK, do("/users/2/next-takings", http.Header{"X-Api-Key": {"secret"}}).Code, "other user of api key")
+
+	req := httptest.NewRequest(http.MethodGet, "/users/1/next-takings", nil)
+	req = req.WithContext(contextx.WithClientIdentity(req.Context(), &contextx.ClientIdentity{CommonName: "client"}))
+	rec = httptest.NewRecorder()
+	rtr.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code, "verified client has own bucket")
+
+	for range 3 {
+		require.Equal(t, http.StatusOK, do("/healthz", nil).Code, "skipped path")
+	}
+}
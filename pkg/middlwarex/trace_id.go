@@ -1,23 +1,68 @@
 package middlwarex
 
 import (
-	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
 	"net/http"
 	"schedule/pkg/contextx"
 )
 
 const headerTraceId = "X-Trace-Id"
 
+var tracer = otel.Tracer("schedule/pkg/middlwarex")
+
+// AddTraceId continues trace from W3C traceparent header or starts new one, and starts span of request.
+// Without traceparent X-Trace-Id of legacy client is used as trace id of request, it is also kept as span attribute.
+// Trace id is returned in traceparent and X-Trace-Id headers.
 func AddTraceId(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		traceId := r.Header.Get(headerTraceId)
-		if traceId == "" {
-			traceId = uuid.NewString()
-			w.Header().Set(headerTraceId, traceId)
+		propagator := otel.GetTextMapPropagator()
+		ctx := propagator.Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+		hasParent := trace.SpanContextFromContext(ctx).IsValid()
+
+		route := routeTemplate(r)
+		ctx, span := tracer.Start(ctx, r.Method+" "+route,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				attribute.String("http.request.method", r.Method),
+				attribute.String("http.route", route),
+				attribute.String("url.path", r.URL.Path),
+			),
+		)
+		defer span.End()
+
+		traceId := span.SpanContext().TraceID().String()
+		if clientTraceId := r.Header.Get(headerTraceId); clientTraceId != "" { // legacy clients
+			span.SetAttributes(attribute.String("client.trace_id", clientTraceId))
+			if !hasParent {
+				traceId = clientTraceId
+			}
 		}
 
-		ctx := contextx.WithTraceId(r.Context(), contextx.TraceId(traceId))
+		propagator.Inject(ctx, propagation.HeaderCarrier(w.Header()))
+		w.Header().Set(headerTraceId, traceId)
+
+		ctx = contextx.WithTraceId(ctx, contextx.TraceId(traceId))
+
+		sw := &statusWriter{ResponseWriter: w, statusCode: http.StatusOK}
+		next.ServeHTTP(sw, r.WithContext(ctx))
 
-		next.ServeHTTP(w, r.WithContext(ctx))
+		span.SetAttributes(attribute.Int("http.response.status_code", sw.statusCode))
+		if sw.statusCode >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, http.StatusText(sw.statusCode))
+		}
 	})
 }
+
+func routeTemplate(r *http.Request) string {
+	if route := mux.CurrentRoute(r); route != nil {
+		if tpl, err := route.GetPathTemplate(); err == nil {
+			return tpl
+		}
+	}
+	return "unmatched"
+}
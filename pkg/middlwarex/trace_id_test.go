@@ -0,0 +1,79 @@
+package middlwarex
+
+import (
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"net/http"
+	"net/http/httptest"
+	"schedule/pkg/contextx"
+	"strings"
+	"testing"
+)
+
+func TestAddTraceId(t *testing.T) {
+	otel.SetTracerProvider(sdktrace.NewTracerProvider())
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	const (
+		parentTraceId = "4bf92f3577b34da6a3ce929d0e0e4736"
+		traceparent   = "00-" + parentTraceId + "-00f067aa0ba902b7-01"
+	)
+
+	var handledTraceId contextx.TraceId
+
+	rtr := mux.NewRouter()
+	rtr.Use(AddTraceId)
+	rtr.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		handledTraceId = contextx.GetTraceId(r.Context())
+	})
+
+	t.Run("continue trace", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("traceparent", traceparent)
+		rec := httptest.NewRecorder()
+
+		rtr.ServeHTTP(rec, req)
+
+		require.Equal(t, contextx.TraceId(parentTraceId), handledTraceId)
+		require.Equal(t, parentTraceId, rec.Header().Get(headerTraceId))
+		require.True(t, strings.HasPrefix(rec.Header().Get("traceparent"), "00-"+parentTraceId+"-"))
+		require.NotEqual(t, traceparent, rec.Header().Get("traceparent")) // span id of server span
+	})
+
+	t.Run("legacy trace id", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set(headerTraceId, "legacy-trace-id")
+		rec := httptest.NewRecorder()
+
+		rtr.ServeHTTP(rec, req)
+
+		require.Equal(t, contextx.TraceId("legacy-trace-id"), handledTraceId)
+		require.Equal(t, "legacy-trace-id", rec.Header().Get(headerTraceId))
+	})
+
+	t.Run("traceparent wins over legacy trace id", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set("traceparent", traceparent)
+		req.Header.Set(headerTraceId, "legacy-trace-id")
+		rec := httptest.NewRecorder()
+
+		rtr.ServeHTTP(rec, req)
+
+		require.Equal(t, contextx.TraceId(parentTraceId), handledTraceId)
+		require.Equal(t, parentTraceId, rec.Header().Get(headerTraceId))
+	})
+
+	t.Run("new trace", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+
+		rtr.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+
+		require.Len(t, handledTraceId, 32)
+		require.NotEqual(t, contextx.TraceId(parentTraceId), handledTraceId)
+		require.Equal(t, string(handledTraceId), rec.Header().Get(headerTraceId))
+		require.True(t, strings.HasPrefix(rec.Header().Get("traceparent"), "00-"+string(handledTraceId)+"-"))
+	})
+}
@@ -0,0 +1,92 @@
+package ratelimit
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+)
+
+// KeyMode selects what requests are limited by.
+type KeyMode string
+
+const (
+	KeyClient KeyMode = "client"  // verified client certificate or api key
+	KeyAPIKey KeyMode = "api_key" // configured api key
+	KeyUser   KeyMode = "user"    // user of authenticated caller
+	KeyIP     KeyMode = "ip"      // client ip
+)
+
+// Caller is what is known about request source.
+type Caller struct {
+	ClientName string // name of verified client certificate
+	APIKey     string // api key as sent, it is checked by Keyer
+	UserId     string // user id of request, it is chosen by caller
+	IP         string
+}
+
+// Keyer builds bucket key of caller.
+// Unauthenticated callers are always limited by ip, so they can't get fresh bucket by changing api key or user id.
+type Keyer struct {
+	mode    KeyMode
+	apiKeys map[string]struct{}
+}
+
+func NewKeyer(mode KeyMode, apiKeys []string) (*Keyer, error) {
+	switch mode {
+	case KeyClient, KeyAPIKey, KeyUser, KeyIP:
+	default:
+		return nil, fmt.Errorf("unknown rate limit key mode %q", mode)
+	}
+
+	k := &Keyer{
+		mode:    mode,
+		apiKeys: make(map[string]struct{}, len(apiKeys)),
+	}
+	for _, key := range apiKeys {
+		if key != "" {
+			k.apiKeys[key] = struct{}{}
+		}
+	}
+	return k, nil
+}
+
+func (k *Keyer) Key(c Caller) string {
+	switch k.mode {
+	case KeyClient:
+		if id := k.identity(c); id != "" {
+			return id
+		}
+	case KeyAPIKey:
+		if id := k.apiKeyIdentity(c); id != "" {
+			return id
+		}
+	case KeyUser:
+		if id := k.identity(c); id != "" {
+			if c.UserId == "" {
+				return id
+			}
+			return id + ":user:" + c.UserId
+		}
+	}
+
+	if c.IP == "" {
+		return "unknown"
+	}
+	return "ip:" + c.IP
+}
+
+func (k *Keyer) identity(c Caller) string {
+	if c.ClientName != "" {
+		return "client:" + c.ClientName
+	}
+	return k.apiKeyIdentity(c)
+}
+
+// apiKeyIdentity returns hash of configured api key, so keys are not kept in store.
+func (k *Keyer) apiKeyIdentity(c Caller) string {
+	if _, ok := k.apiKeys[c.APIKey]; !ok || c.APIKey == "" {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(c.APIKey))
+	return "api_key:" + hex.EncodeToString(sum[:8])
+}
@@ -0,0 +1,40 @@
+package ratelimit
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestKeyer(t *testing.T) {
+	_, err := NewKeyer("session", nil)
+	require.Error(t, err)
+
+	anonymous := Caller{APIKey: "random", UserId: "1", IP: "10.0.0.1"}
+	client := Caller{ClientName: "client", UserId: "1", IP: "10.0.0.1"}
+	withAPIKey := Caller{APIKey: "secret", UserId: "1", IP: "10.0.0.1"}
+
+	tests := []struct {
+		name   string
+		mode   KeyMode
+		caller Caller
+		want   string
+	}{
+		{"client", KeyClient, client, "client:client"},
+		{"client by api key", KeyClient, withAPIKey, "api_key:2bb80d537b1da3e3"},
+		{"client anonymous", KeyClient, anonymous, "ip:10.0.0.1"},
+		{"api key", KeyAPIKey, withAPIKey, "api_key:2bb80d537b1da3e3"},
+		{"api key without key", KeyAPIKey, client, "ip:10.0.0.1"},
+		{"user", KeyUser, client, "client:client:user:1"},
+		{"user anonymous", KeyUser, anonymous, "ip:10.0.0.1"},
+		{"user without user", KeyUser, Caller{ClientName: "client", IP: "10.0.0.1"}, "client:client"},
+		{"ip", KeyIP, client, "ip:10.0.0.1"},
+		{"unknown", KeyIP, Caller{}, "unknown"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keyer, err := NewKeyer(tt.mode, []string{"secret"})
+			require.NoError(t, err)
+			require.Equal(t, tt.want, keyer.Key(tt.caller))
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const sweepInterval = time.Minute
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// MemoryStore keeps buckets in process, limits are per replica.
+type MemoryStore struct {
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		buckets: make(map[string]*bucket),
+		now:     time.Now,
+	}
+}
+
+func (s *MemoryStore) Take(_ context.Context, key string, limit Limit) (Result, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	s.sweep(now, limit)
+
+	b, ok := s.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(limit.Burst), last: now}
+		s.buckets[key] = b
+	}
+
+	b.tokens = min(float64(limit.Burst), b.tokens+now.Sub(b.last).Seconds()*limit.Rate)
+	b.last = now
+
+	if b.tokens < 1 {
+		return Result{
+			RetryAfter: time.Duration((1 - b.tokens) / limit.Rate * float64(time.Second)),
+		}, nil
+	}
+
+	b.tokens--
+
+	return Result{
+		Allowed:   true,
+		Remaining: int(b.tokens),
+	}, nil
+}
+
+// sweep removes buckets which are full again, they are same as missing ones.
+func (s *MemoryStore) sweep(now time.Time, limit Limit) {
+	if now.Sub(s.lastSweep) < sweepInterval {
+		return
+	}
+	s.lastSweep = now
+
+	refill := time.Duration(float64(limit.Burst) / limit.Rate * float64(time.Second))
+	for key, b := range s.buckets {
+		if now.Sub(b.last) >= refill {
+			delete(s.buckets, key)
+		}
+	}
+}
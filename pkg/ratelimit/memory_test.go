@@ -0,0 +1,49 @@
+package ratelimit
+
+import (
+	"context"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestMemoryStore(t *testing.T) {
+	ctx := context.Background()
+	limit := Limit{Rate: 2, Burst: 3}
+
+	now := time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC)
+	store := NewMemoryStore()
+	store.now = func() time.Time { return now }
+
+	for i := range limit.Burst {
+		res, err := store.Take(ctx, "user:1", limit)
+		require.NoError(t, err)
+		require.True(t, res.Allowed)
+		require.Equal(t, limit.Burst-1-i, res.Remaining)
+	}
+
+	res, err := store.Take(ctx, "user:1", limit)
+	require.NoError(t, err)
+	require.False(t, res.Allowed)
+	require.Equal(t, 500*time.Millisecond, res.RetryAfter)
+
+	res, err = store.Take(ctx, "user:2", limit)
+	require.NoError(t, err)
+	require.True(t, res.Allowed, "keys have separate buckets")
+
+	now = now.Add(500 * time.Millisecond)
+	res, err = store.Take(ctx, "user:1", limit)
+	require.NoError(t, err)
+	require.True(t, res.Allowed)
+
+	now = now.Add(sweepInterval)
+	_, err = store.Take(ctx, "user:3", limit)
+	require.NoError(t, err)
+	require.Len(t, store.buckets, 1, "full buckets are swept")
+}
+
+func TestRetryAfterSeconds(t *testing.T) {
+	require.Equal(t, 1, RetryAfterSeconds(0))
+	require.Equal(t, 1, RetryAfterSeconds(300*time.Millisecond))
+	require.Equal(t, 2, RetryAfterSeconds(1100*time.Millisecond))
+}
@@ -0,0 +1,47 @@
+// Package ratelimit implements token bucket rate limiting with pluggable bucket store.
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"time"
+)
+
+// Limit is token bucket parameters.
+type Limit struct {
+	Rate  float64 // tokens added per second
+	Burst int     // bucket capacity
+}
+
+// Result of taking token from bucket.
+type Result struct {
+	Allowed    bool
+	Remaining  int
+	RetryAfter time.Duration // when next token is available, zero if allowed
+}
+
+// Store keeps buckets, shared store (e.g. redis) allows to limit across replicas.
+type Store interface {
+	Take(ctx context.Context, key string, limit Limit) (Result, error)
+}
+
+type Limiter struct {
+	store Store
+	limit Limit
+}
+
+func NewLimiter(store Store, limit Limit) *Limiter {
+	return &Limiter{
+		store: store,
+		limit: limit,
+	}
+}
+
+func (l *Limiter) Allow(ctx context.Context, key string) (Result, error) {
+	return l.store.Take(ctx, key, l.limit)
+}
+
+// RetryAfterSeconds rounds retry delay up to whole seconds for Retry-After header.
+func RetryAfterSeconds(d time.Duration) int {
+	return max(1, int(math.Ceil(d.Seconds())))
+}
@@ -102,6 +102,61 @@ type ClientInterface interface {
 
 	// GetSchedules request
 	GetSchedules(ctx context.Context, params *GetSchedulesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostSchedulesImportWithBody request with any body
+	PostSchedulesImportWithBody(ctx context.Context, params *PostSchedulesImportParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSchedulesList request
+	GetSchedulesList(ctx context.Context, params *GetSchedulesListParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUserDayPlan request
+	GetUserDayPlan(ctx context.Context, userId int, params *GetUserDayPlanParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PreviewUserDayPlanConsolidation request
+	PreviewUserDayPlanConsolidation(ctx context.Context, userId int, params *PreviewUserDayPlanConsolidationParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUserNextTakings request
+	GetUserNextTakings(ctx context.Context, userId int, params *GetUserNextTakingsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListUserSchedules request
+	ListUserSchedules(ctx context.Context, userId int, params *ListUserSchedulesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateUserScheduleWithBody request with any body
+	CreateUserScheduleWithBody(ctx context.Context, userId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateUserSchedule(ctx context.Context, userId int, body CreateUserScheduleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PreviewUserScheduleWithBody request with any body
+	PreviewUserScheduleWithBody(ctx context.Context, userId int, params *PreviewUserScheduleParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PreviewUserSchedule(ctx context.Context, userId int, params *PreviewUserScheduleParams, body PreviewUserScheduleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteUserSchedule request
+	DeleteUserSchedule(ctx context.Context, userId int, id int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUserSchedule request
+	GetUserSchedule(ctx context.Context, userId int, id int, params *GetUserScheduleParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateUserScheduleWithBody request with any body
+	UpdateUserScheduleWithBody(ctx context.Context, userId int, id int, params *UpdateUserScheduleParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateUserSchedule(ctx context.Context, userId int, id int, params *UpdateUserScheduleParams, body UpdateUserScheduleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUserScheduleHistory request
+	GetUserScheduleHistory(ctx context.Context, userId int, id int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RecordUserScheduleIntakeWithBody request with any body
+	RecordUserScheduleIntakeWithBody(ctx context.Context, userId int, id int, params *RecordUserScheduleIntakeParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	RecordUserScheduleIntake(ctx context.Context, userId int, id int, params *RecordUserScheduleIntakeParams, body RecordUserScheduleIntakeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUserSettings request
+	GetUserSettings(ctx context.Context, userId int, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateUserSettingsWithBody request with any body
+	UpdateUserSettingsWithBody(ctx context.Context, userId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateUserSettings(ctx context.Context, userId int, body UpdateUserSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
 func (c *Client) GetNextTaking(ctx context.Context, params *GetNextTakingParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -164,6 +219,246 @@ func (c *Client) GetSchedules(ctx context.Context, params *GetSchedulesParams, r
 	return c.Client.Do(req)
 }
 
+func (c *Client) PostSchedulesImportWithBody(ctx context.Context, params *PostSchedulesImportParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostSchedulesImportRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetSchedulesList(ctx context.Context, params *GetSchedulesListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSchedulesListRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetUserDayPlan(ctx context.Context, userId int, params *GetUserDayPlanParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUserDayPlanRequest(c.Server, userId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PreviewUserDayPlanConsolidation(ctx context.Context, userId int, params *PreviewUserDayPlanConsolidationParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPreviewUserDayPlanConsolidationRequest(c.Server, userId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetUserNextTakings(ctx context.Context, userId int, params *GetUserNextTakingsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUserNextTakingsRequest(c.Server, userId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListUserSchedules(ctx context.Context, userId int, params *ListUserSchedulesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListUserSchedulesRequest(c.Server, userId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateUserScheduleWithBody(ctx context.Context, userId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateUserScheduleRequestWithBody(c.Server, userId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateUserSchedule(ctx context.Context, userId int, body CreateUserScheduleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateUserScheduleRequest(c.Server, userId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PreviewUserScheduleWithBody(ctx context.Context, userId int, params *PreviewUserScheduleParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPreviewUserScheduleRequestWithBody(c.Server, userId, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PreviewUserSchedule(ctx context.Context, userId int, params *PreviewUserScheduleParams, body PreviewUserScheduleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPreviewUserScheduleRequest(c.Server, userId, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteUserSchedule(ctx context.Context, userId int, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteUserScheduleRequest(c.Server, userId, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetUserSchedule(ctx context.Context, userId int, id int, params *GetUserScheduleParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUserScheduleRequest(c.Server, userId, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateUserScheduleWithBody(ctx context.Context, userId int, id int, params *UpdateUserScheduleParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateUserScheduleRequestWithBody(c.Server, userId, id, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateUserSchedule(ctx context.Context, userId int, id int, params *UpdateUserScheduleParams, body UpdateUserScheduleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateUserScheduleRequest(c.Server, userId, id, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetUserScheduleHistory(ctx context.Context, userId int, id int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUserScheduleHistoryRequest(c.Server, userId, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RecordUserScheduleIntakeWithBody(ctx context.Context, userId int, id int, params *RecordUserScheduleIntakeParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRecordUserScheduleIntakeRequestWithBody(c.Server, userId, id, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RecordUserScheduleIntake(ctx context.Context, userId int, id int, params *RecordUserScheduleIntakeParams, body RecordUserScheduleIntakeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRecordUserScheduleIntakeRequest(c.Server, userId, id, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetUserSettings(ctx context.Context, userId int, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUserSettingsRequest(c.Server, userId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateUserSettingsWithBody(ctx context.Context, userId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateUserSettingsRequestWithBody(c.Server, userId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateUserSettings(ctx context.Context, userId int, body UpdateUserSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateUserSettingsRequest(c.Server, userId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 // NewGetNextTakingRequest generates requests for GetNextTaking
 func NewGetNextTakingRequest(server string, params *GetNextTakingParams) (*http.Request, error) {
 	var err error
@@ -198,6 +493,22 @@ func NewGetNextTakingRequest(server string, params *GetNextTakingParams) (*http.
 			}
 		}
 
+		if params.Explain != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "explain", runtime.ParamLocationQuery, *params.Explain); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
 		queryURL.RawQuery = queryValues.Encode()
 	}
 
@@ -270,6 +581,22 @@ func NewGetScheduleRequest(server string, params *GetScheduleParams) (*http.Requ
 			}
 		}
 
+		if params.Explain != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "explain", runtime.ParamLocationQuery, *params.Explain); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
 		queryURL.RawQuery = queryValues.Encode()
 	}
 
@@ -396,221 +723,2704 @@ func NewGetSchedulesRequest(server string, params *GetSchedulesParams) (*http.Re
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+// NewPostSchedulesImportRequestWithBody generates requests for PostSchedulesImport with any type of body
+func NewPostSchedulesImportRequestWithBody(server string, params *PostSchedulesImportParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+
+	operationPath := fmt.Sprintf("/schedules/import")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return nil
-}
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Mode != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "mode", runtime.ParamLocationQuery, *params.Mode); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetSchedulesListRequest generates requests for GetSchedulesList
+func NewGetSchedulesListRequest(server string, params *GetSchedulesListParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/schedules/list")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, params.UserId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Name != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "name", runtime.ParamLocationQuery, *params.Name); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.EndAtFrom != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "end_at_from", runtime.ParamLocationQuery, *params.EndAtFrom); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.EndAtTo != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "end_at_to", runtime.ParamLocationQuery, *params.EndAtTo); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Sort != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Order != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "order", runtime.ParamLocationQuery, *params.Order); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.TZ != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "TZ", runtime.ParamLocationHeader, *params.TZ)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("TZ", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetUserDayPlanRequest generates requests for GetUserDayPlan
+func NewGetUserDayPlanRequest(server string, userId int, params *GetUserDayPlanParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/users/%s/day-plan", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Date != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "date", runtime.ParamLocationQuery, *params.Date); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.TZ != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "TZ", runtime.ParamLocationHeader, *params.TZ)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("TZ", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPreviewUserDayPlanConsolidationRequest generates requests for PreviewUserDayPlanConsolidation
+func NewPreviewUserDayPlanConsolidationRequest(server string, userId int, params *PreviewUserDayPlanConsolidationParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/users/%s/day-plan/consolidated", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Date != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "date", runtime.ParamLocationQuery, *params.Date); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.TZ != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "TZ", runtime.ParamLocationHeader, *params.TZ)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("TZ", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetUserNextTakingsRequest generates requests for GetUserNextTakings
+func NewGetUserNextTakingsRequest(server string, userId int, params *GetUserNextTakingsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/users/%s/next-takings", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Explain != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "explain", runtime.ParamLocationQuery, *params.Explain); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.TZ != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "TZ", runtime.ParamLocationHeader, *params.TZ)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("TZ", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewListUserSchedulesRequest generates requests for ListUserSchedules
+func NewListUserSchedulesRequest(server string, userId int, params *ListUserSchedulesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/users/%s/schedules", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Name != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "name", runtime.ParamLocationQuery, *params.Name); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.EndAtFrom != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "end_at_from", runtime.ParamLocationQuery, *params.EndAtFrom); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.EndAtTo != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "end_at_to", runtime.ParamLocationQuery, *params.EndAtTo); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Sort != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Order != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "order", runtime.ParamLocationQuery, *params.Order); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.TZ != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "TZ", runtime.ParamLocationHeader, *params.TZ)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("TZ", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewCreateUserScheduleRequest calls the generic CreateUserSchedule builder with application/json body
+func NewCreateUserScheduleRequest(server string, userId int, body CreateUserScheduleJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateUserScheduleRequestWithBody(server, userId, "application/json", bodyReader)
+}
+
+// NewCreateUserScheduleRequestWithBody generates requests for CreateUserSchedule with any type of body
+func NewCreateUserScheduleRequestWithBody(server string, userId int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/users/%s/schedules", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPreviewUserScheduleRequest calls the generic PreviewUserSchedule builder with application/json body
+func NewPreviewUserScheduleRequest(server string, userId int, params *PreviewUserScheduleParams, body PreviewUserScheduleJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPreviewUserScheduleRequestWithBody(server, userId, params, "application/json", bodyReader)
+}
+
+// NewPreviewUserScheduleRequestWithBody generates requests for PreviewUserSchedule with any type of body
+func NewPreviewUserScheduleRequestWithBody(server string, userId int, params *PreviewUserScheduleParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/users/%s/schedules/preview", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Days != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "days", runtime.ParamLocationQuery, *params.Days); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.TZ != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "TZ", runtime.ParamLocationHeader, *params.TZ)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("TZ", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewDeleteUserScheduleRequest generates requests for DeleteUserSchedule
+func NewDeleteUserScheduleRequest(server string, userId int, id int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/users/%s/schedules/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetUserScheduleRequest generates requests for GetUserSchedule
+func NewGetUserScheduleRequest(server string, userId int, id int, params *GetUserScheduleParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/users/%s/schedules/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Explain != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "explain", runtime.ParamLocationQuery, *params.Explain); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.TZ != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "TZ", runtime.ParamLocationHeader, *params.TZ)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("TZ", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewUpdateUserScheduleRequest calls the generic UpdateUserSchedule builder with application/json body
+func NewUpdateUserScheduleRequest(server string, userId int, id int, params *UpdateUserScheduleParams, body UpdateUserScheduleJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateUserScheduleRequestWithBody(server, userId, id, params, "application/json", bodyReader)
+}
+
+// NewUpdateUserScheduleRequestWithBody generates requests for UpdateUserSchedule with any type of body
+func NewUpdateUserScheduleRequestWithBody(server string, userId int, id int, params *UpdateUserScheduleParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/users/%s/schedules/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.TZ != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "TZ", runtime.ParamLocationHeader, *params.TZ)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("TZ", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetUserScheduleHistoryRequest generates requests for GetUserScheduleHistory
+func NewGetUserScheduleHistoryRequest(server string, userId int, id int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/users/%s/schedules/%s/history", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRecordUserScheduleIntakeRequest calls the generic RecordUserScheduleIntake builder with application/json body
+func NewRecordUserScheduleIntakeRequest(server string, userId int, id int, params *RecordUserScheduleIntakeParams, body RecordUserScheduleIntakeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRecordUserScheduleIntakeRequestWithBody(server, userId, id, params, "application/json", bodyReader)
+}
+
+// NewRecordUserScheduleIntakeRequestWithBody generates requests for RecordUserScheduleIntake with any type of body
+func NewRecordUserScheduleIntakeRequestWithBody(server string, userId int, id int, params *RecordUserScheduleIntakeParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/users/%s/schedules/%s/intakes", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.TZ != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "TZ", runtime.ParamLocationHeader, *params.TZ)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("TZ", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetUserSettingsRequest generates requests for GetUserSettings
+func NewGetUserSettingsRequest(server string, userId int) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/users/%s/settings", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateUserSettingsRequest calls the generic UpdateUserSettings builder with application/json body
+func NewUpdateUserSettingsRequest(server string, userId int, body UpdateUserSettingsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateUserSettingsRequestWithBody(server, userId, "application/json", bodyReader)
+}
+
+// NewUpdateUserSettingsRequestWithBody generates requests for UpdateUserSettings with any type of body
+func NewUpdateUserSettingsRequestWithBody(server string, userId int, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/users/%s/settings", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
 type ClientWithResponses struct {
 	ClientInterface
 }
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetNextTakingWithResponse request
+	GetNextTakingWithResponse(ctx context.Context, params *GetNextTakingParams, reqEditors ...RequestEditorFn) (*GetNextTakingResponse, error)
+
+	// GetScheduleWithResponse request
+	GetScheduleWithResponse(ctx context.Context, params *GetScheduleParams, reqEditors ...RequestEditorFn) (*GetScheduleResponse, error)
+
+	// PostScheduleWithBodyWithResponse request with any body
+	PostScheduleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostScheduleResponse, error)
+
+	PostScheduleWithResponse(ctx context.Context, body PostScheduleJSONRequestBody, reqEditors ...RequestEditorFn) (*PostScheduleResponse, error)
+
+	// GetSchedulesWithResponse request
+	GetSchedulesWithResponse(ctx context.Context, params *GetSchedulesParams, reqEditors ...RequestEditorFn) (*GetSchedulesResponse, error)
+
+	// PostSchedulesImportWithBodyWithResponse request with any body
+	PostSchedulesImportWithBodyWithResponse(ctx context.Context, params *PostSchedulesImportParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSchedulesImportResponse, error)
+
+	// GetSchedulesListWithResponse request
+	GetSchedulesListWithResponse(ctx context.Context, params *GetSchedulesListParams, reqEditors ...RequestEditorFn) (*GetSchedulesListResponse, error)
+
+	// GetUserDayPlanWithResponse request
+	GetUserDayPlanWithResponse(ctx context.Context, userId int, params *GetUserDayPlanParams, reqEditors ...RequestEditorFn) (*GetUserDayPlanResponse, error)
+
+	// PreviewUserDayPlanConsolidationWithResponse request
+	PreviewUserDayPlanConsolidationWithResponse(ctx context.Context, userId int, params *PreviewUserDayPlanConsolidationParams, reqEditors ...RequestEditorFn) (*PreviewUserDayPlanConsolidationResponse, error)
+
+	// GetUserNextTakingsWithResponse request
+	GetUserNextTakingsWithResponse(ctx context.Context, userId int, params *GetUserNextTakingsParams, reqEditors ...RequestEditorFn) (*GetUserNextTakingsResponse, error)
+
+	// ListUserSchedulesWithResponse request
+	ListUserSchedulesWithResponse(ctx context.Context, userId int, params *ListUserSchedulesParams, reqEditors ...RequestEditorFn) (*ListUserSchedulesResponse, error)
+
+	// CreateUserScheduleWithBodyWithResponse request with any body
+	CreateUserScheduleWithBodyWithResponse(ctx context.Context, userId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateUserScheduleResponse, error)
+
+	CreateUserScheduleWithResponse(ctx context.Context, userId int, body CreateUserScheduleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateUserScheduleResponse, error)
+
+	// PreviewUserScheduleWithBodyWithResponse request with any body
+	PreviewUserScheduleWithBodyWithResponse(ctx context.Context, userId int, params *PreviewUserScheduleParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PreviewUserScheduleResponse, error)
+
+	PreviewUserScheduleWithResponse(ctx context.Context, userId int, params *PreviewUserScheduleParams, body PreviewUserScheduleJSONRequestBody, reqEditors ...RequestEditorFn) (*PreviewUserScheduleResponse, error)
+
+	// DeleteUserScheduleWithResponse request
+	DeleteUserScheduleWithResponse(ctx context.Context, userId int, id int, reqEditors ...RequestEditorFn) (*DeleteUserScheduleResponse, error)
+
+	// GetUserScheduleWithResponse request
+	GetUserScheduleWithResponse(ctx context.Context, userId int, id int, params *GetUserScheduleParams, reqEditors ...RequestEditorFn) (*GetUserScheduleResponse, error)
+
+	// UpdateUserScheduleWithBodyWithResponse request with any body
+	UpdateUserScheduleWithBodyWithResponse(ctx context.Context, userId int, id int, params *UpdateUserScheduleParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateUserScheduleResponse, error)
+
+	UpdateUserScheduleWithResponse(ctx context.Context, userId int, id int, params *UpdateUserScheduleParams, body UpdateUserScheduleJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateUserScheduleResponse, error)
+
+	// GetUserScheduleHistoryWithResponse request
+	GetUserScheduleHistoryWithResponse(ctx context.Context, userId int, id int, reqEditors ...RequestEditorFn) (*GetUserScheduleHistoryResponse, error)
+
+	// RecordUserScheduleIntakeWithBodyWithResponse request with any body
+	RecordUserScheduleIntakeWithBodyWithResponse(ctx context.Context, userId int, id int, params *RecordUserScheduleIntakeParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RecordUserScheduleIntakeResponse, error)
+
+	RecordUserScheduleIntakeWithResponse(ctx context.Context, userId int, id int, params *RecordUserScheduleIntakeParams, body RecordUserScheduleIntakeJSONRequestBody, reqEditors ...RequestEditorFn) (*RecordUserScheduleIntakeResponse, error)
+
+	// GetUserSettingsWithResponse request
+	GetUserSettingsWithResponse(ctx context.Context, userId int, reqEditors ...RequestEditorFn) (*GetUserSettingsResponse, error)
+
+	// UpdateUserSettingsWithBodyWithResponse request with any body
+	UpdateUserSettingsWithBodyWithResponse(ctx context.Context, userId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateUserSettingsResponse, error)
+
+	UpdateUserSettingsWithResponse(ctx context.Context, userId int, body UpdateUserSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateUserSettingsResponse, error)
+}
+
+type GetNextTakingResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *[]NextTakingResponse
+	ApplicationproblemJSON400 *ErrorResponse
+	ApplicationproblemJSON429 *ErrorResponse
+	ApplicationproblemJSON500 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetNextTakingResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetNextTakingResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetScheduleResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *ScheduleResponse
+	ApplicationproblemJSON400 *ErrorResponse
+	ApplicationproblemJSON404 *ErrorResponse
+	ApplicationproblemJSON429 *ErrorResponse
+	ApplicationproblemJSON500 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetScheduleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetScheduleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostScheduleResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *CreateScheduleResponse
+	ApplicationproblemJSON400 *ErrorResponse
+	ApplicationproblemJSON429 *ErrorResponse
+	ApplicationproblemJSON500 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostScheduleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostScheduleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSchedulesResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *[]int
+	ApplicationproblemJSON400 *ErrorResponse
+	ApplicationproblemJSON429 *ErrorResponse
+	ApplicationproblemJSON500 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSchedulesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSchedulesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostSchedulesImportResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *ImportSchedulesResponse
+	ApplicationproblemJSON400 *ErrorResponse
+	ApplicationproblemJSON429 *ErrorResponse
+	ApplicationproblemJSON500 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostSchedulesImportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostSchedulesImportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSchedulesListResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *SchedulesPageResponse
+	ApplicationproblemJSON400 *ErrorResponse
+	ApplicationproblemJSON429 *ErrorResponse
+	ApplicationproblemJSON500 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSchedulesListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSchedulesListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUserDayPlanResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *DayPlanResponse
+	ApplicationproblemJSON400 *ErrorResponse
+	ApplicationproblemJSON429 *ErrorResponse
+	ApplicationproblemJSON500 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUserDayPlanResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUserDayPlanResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PreviewUserDayPlanConsolidationResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *DayPlanResponse
+	ApplicationproblemJSON400 *ErrorResponse
+	ApplicationproblemJSON429 *ErrorResponse
+	ApplicationproblemJSON500 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PreviewUserDayPlanConsolidationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PreviewUserDayPlanConsolidationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUserNextTakingsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *[]NextTakingResponse
+	ApplicationproblemJSON400 *ErrorResponse
+	ApplicationproblemJSON429 *ErrorResponse
+	ApplicationproblemJSON500 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUserNextTakingsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUserNextTakingsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListUserSchedulesResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *SchedulesPageResponse
+	ApplicationproblemJSON400 *ErrorResponse
+	ApplicationproblemJSON429 *ErrorResponse
+	ApplicationproblemJSON500 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListUserSchedulesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListUserSchedulesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateUserScheduleResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON201                   *CreateScheduleResponse
+	ApplicationproblemJSON400 *ErrorResponse
+	ApplicationproblemJSON429 *ErrorResponse
+	ApplicationproblemJSON500 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateUserScheduleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateUserScheduleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PreviewUserScheduleResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *SchedulePreviewResponse
+	ApplicationproblemJSON400 *ErrorResponse
+	ApplicationproblemJSON429 *ErrorResponse
+	ApplicationproblemJSON500 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PreviewUserScheduleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PreviewUserScheduleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteUserScheduleResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON400 *ErrorResponse
+	ApplicationproblemJSON404 *ErrorResponse
+	ApplicationproblemJSON429 *ErrorResponse
+	ApplicationproblemJSON500 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteUserScheduleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteUserScheduleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUserScheduleResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *ScheduleResponse
+	ApplicationproblemJSON400 *ErrorResponse
+	ApplicationproblemJSON404 *ErrorResponse
+	ApplicationproblemJSON429 *ErrorResponse
+	ApplicationproblemJSON500 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUserScheduleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUserScheduleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateUserScheduleResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *ScheduleResponse
+	ApplicationproblemJSON400 *ErrorResponse
+	ApplicationproblemJSON404 *ErrorResponse
+	ApplicationproblemJSON429 *ErrorResponse
+	ApplicationproblemJSON500 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateUserScheduleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateUserScheduleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUserScheduleHistoryResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *[]AuditRecord
+	ApplicationproblemJSON400 *ErrorResponse
+	ApplicationproblemJSON429 *ErrorResponse
+	ApplicationproblemJSON500 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUserScheduleHistoryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUserScheduleHistoryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RecordUserScheduleIntakeResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *ScheduleResponse
+	ApplicationproblemJSON400 *ErrorResponse
+	ApplicationproblemJSON404 *ErrorResponse
+	ApplicationproblemJSON429 *ErrorResponse
+	ApplicationproblemJSON500 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RecordUserScheduleIntakeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RecordUserScheduleIntakeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUserSettingsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *UserSettings
+	ApplicationproblemJSON400 *ErrorResponse
+	ApplicationproblemJSON429 *ErrorResponse
+	ApplicationproblemJSON500 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUserSettingsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUserSettingsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateUserSettingsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *UserSettings
+	ApplicationproblemJSON400 *ErrorResponse
+	ApplicationproblemJSON429 *ErrorResponse
+	ApplicationproblemJSON500 *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateUserSettingsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateUserSettingsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetNextTakingWithResponse request returning *GetNextTakingResponse
+func (c *ClientWithResponses) GetNextTakingWithResponse(ctx context.Context, params *GetNextTakingParams, reqEditors ...RequestEditorFn) (*GetNextTakingResponse, error) {
+	rsp, err := c.GetNextTaking(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetNextTakingResponse(rsp)
+}
+
+// GetScheduleWithResponse request returning *GetScheduleResponse
+func (c *ClientWithResponses) GetScheduleWithResponse(ctx context.Context, params *GetScheduleParams, reqEditors ...RequestEditorFn) (*GetScheduleResponse, error) {
+	rsp, err := c.GetSchedule(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetScheduleResponse(rsp)
+}
+
+// PostScheduleWithBodyWithResponse request with arbitrary body returning *PostScheduleResponse
+func (c *ClientWithResponses) PostScheduleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostScheduleResponse, error) {
+	rsp, err := c.PostScheduleWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostScheduleResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostScheduleWithResponse(ctx context.Context, body PostScheduleJSONRequestBody, reqEditors ...RequestEditorFn) (*PostScheduleResponse, error) {
+	rsp, err := c.PostSchedule(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostScheduleResponse(rsp)
+}
+
+// GetSchedulesWithResponse request returning *GetSchedulesResponse
+func (c *ClientWithResponses) GetSchedulesWithResponse(ctx context.Context, params *GetSchedulesParams, reqEditors ...RequestEditorFn) (*GetSchedulesResponse, error) {
+	rsp, err := c.GetSchedules(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSchedulesResponse(rsp)
+}
+
+// PostSchedulesImportWithBodyWithResponse request with arbitrary body returning *PostSchedulesImportResponse
+func (c *ClientWithResponses) PostSchedulesImportWithBodyWithResponse(ctx context.Context, params *PostSchedulesImportParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostSchedulesImportResponse, error) {
+	rsp, err := c.PostSchedulesImportWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostSchedulesImportResponse(rsp)
+}
+
+// GetSchedulesListWithResponse request returning *GetSchedulesListResponse
+func (c *ClientWithResponses) GetSchedulesListWithResponse(ctx context.Context, params *GetSchedulesListParams, reqEditors ...RequestEditorFn) (*GetSchedulesListResponse, error) {
+	rsp, err := c.GetSchedulesList(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSchedulesListResponse(rsp)
+}
+
+// GetUserDayPlanWithResponse request returning *GetUserDayPlanResponse
+func (c *ClientWithResponses) GetUserDayPlanWithResponse(ctx context.Context, userId int, params *GetUserDayPlanParams, reqEditors ...RequestEditorFn) (*GetUserDayPlanResponse, error) {
+	rsp, err := c.GetUserDayPlan(ctx, userId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUserDayPlanResponse(rsp)
+}
+
+// PreviewUserDayPlanConsolidationWithResponse request returning *PreviewUserDayPlanConsolidationResponse
+func (c *ClientWithResponses) PreviewUserDayPlanConsolidationWithResponse(ctx context.Context, userId int, params *PreviewUserDayPlanConsolidationParams, reqEditors ...RequestEditorFn) (*PreviewUserDayPlanConsolidationResponse, error) {
+	rsp, err := c.PreviewUserDayPlanConsolidation(ctx, userId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePreviewUserDayPlanConsolidationResponse(rsp)
+}
+
+// GetUserNextTakingsWithResponse request returning *GetUserNextTakingsResponse
+func (c *ClientWithResponses) GetUserNextTakingsWithResponse(ctx context.Context, userId int, params *GetUserNextTakingsParams, reqEditors ...RequestEditorFn) (*GetUserNextTakingsResponse, error) {
+	rsp, err := c.GetUserNextTakings(ctx, userId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUserNextTakingsResponse(rsp)
+}
+
+// ListUserSchedulesWithResponse request returning *ListUserSchedulesResponse
+func (c *ClientWithResponses) ListUserSchedulesWithResponse(ctx context.Context, userId int, params *ListUserSchedulesParams, reqEditors ...RequestEditorFn) (*ListUserSchedulesResponse, error) {
+	rsp, err := c.ListUserSchedules(ctx, userId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListUserSchedulesResponse(rsp)
+}
+
+// CreateUserScheduleWithBodyWithResponse request with arbitrary body returning *CreateUserScheduleResponse
+func (c *ClientWithResponses) CreateUserScheduleWithBodyWithResponse(ctx context.Context, userId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateUserScheduleResponse, error) {
+	rsp, err := c.CreateUserScheduleWithBody(ctx, userId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateUserScheduleResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateUserScheduleWithResponse(ctx context.Context, userId int, body CreateUserScheduleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateUserScheduleResponse, error) {
+	rsp, err := c.CreateUserSchedule(ctx, userId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateUserScheduleResponse(rsp)
+}
+
+// PreviewUserScheduleWithBodyWithResponse request with arbitrary body returning *PreviewUserScheduleResponse
+func (c *ClientWithResponses) PreviewUserScheduleWithBodyWithResponse(ctx context.Context, userId int, params *PreviewUserScheduleParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PreviewUserScheduleResponse, error) {
+	rsp, err := c.PreviewUserScheduleWithBody(ctx, userId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePreviewUserScheduleResponse(rsp)
+}
+
+func (c *ClientWithResponses) PreviewUserScheduleWithResponse(ctx context.Context, userId int, params *PreviewUserScheduleParams, body PreviewUserScheduleJSONRequestBody, reqEditors ...RequestEditorFn) (*PreviewUserScheduleResponse, error) {
+	rsp, err := c.PreviewUserSchedule(ctx, userId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePreviewUserScheduleResponse(rsp)
+}
+
+// DeleteUserScheduleWithResponse request returning *DeleteUserScheduleResponse
+func (c *ClientWithResponses) DeleteUserScheduleWithResponse(ctx context.Context, userId int, id int, reqEditors ...RequestEditorFn) (*DeleteUserScheduleResponse, error) {
+	rsp, err := c.DeleteUserSchedule(ctx, userId, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteUserScheduleResponse(rsp)
+}
+
+// GetUserScheduleWithResponse request returning *GetUserScheduleResponse
+func (c *ClientWithResponses) GetUserScheduleWithResponse(ctx context.Context, userId int, id int, params *GetUserScheduleParams, reqEditors ...RequestEditorFn) (*GetUserScheduleResponse, error) {
+	rsp, err := c.GetUserSchedule(ctx, userId, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUserScheduleResponse(rsp)
+}
+
+// UpdateUserScheduleWithBodyWithResponse request with arbitrary body returning *UpdateUserScheduleResponse
+func (c *ClientWithResponses) UpdateUserScheduleWithBodyWithResponse(ctx context.Context, userId int, id int, params *UpdateUserScheduleParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateUserScheduleResponse, error) {
+	rsp, err := c.UpdateUserScheduleWithBody(ctx, userId, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateUserScheduleResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateUserScheduleWithResponse(ctx context.Context, userId int, id int, params *UpdateUserScheduleParams, body UpdateUserScheduleJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateUserScheduleResponse, error) {
+	rsp, err := c.UpdateUserSchedule(ctx, userId, id, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateUserScheduleResponse(rsp)
+}
+
+// GetUserScheduleHistoryWithResponse request returning *GetUserScheduleHistoryResponse
+func (c *ClientWithResponses) GetUserScheduleHistoryWithResponse(ctx context.Context, userId int, id int, reqEditors ...RequestEditorFn) (*GetUserScheduleHistoryResponse, error) {
+	rsp, err := c.GetUserScheduleHistory(ctx, userId, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUserScheduleHistoryResponse(rsp)
+}
+
+// RecordUserScheduleIntakeWithBodyWithResponse request with arbitrary body returning *RecordUserScheduleIntakeResponse
+func (c *ClientWithResponses) RecordUserScheduleIntakeWithBodyWithResponse(ctx context.Context, userId int, id int, params *RecordUserScheduleIntakeParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RecordUserScheduleIntakeResponse, error) {
+	rsp, err := c.RecordUserScheduleIntakeWithBody(ctx, userId, id, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRecordUserScheduleIntakeResponse(rsp)
+}
+
+func (c *ClientWithResponses) RecordUserScheduleIntakeWithResponse(ctx context.Context, userId int, id int, params *RecordUserScheduleIntakeParams, body RecordUserScheduleIntakeJSONRequestBody, reqEditors ...RequestEditorFn) (*RecordUserScheduleIntakeResponse, error) {
+	rsp, err := c.RecordUserScheduleIntake(ctx, userId, id, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRecordUserScheduleIntakeResponse(rsp)
+}
+
+// GetUserSettingsWithResponse request returning *GetUserSettingsResponse
+func (c *ClientWithResponses) GetUserSettingsWithResponse(ctx context.Context, userId int, reqEditors ...RequestEditorFn) (*GetUserSettingsResponse, error) {
+	rsp, err := c.GetUserSettings(ctx, userId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUserSettingsResponse(rsp)
+}
+
+// UpdateUserSettingsWithBodyWithResponse request with arbitrary body returning *UpdateUserSettingsResponse
+func (c *ClientWithResponses) UpdateUserSettingsWithBodyWithResponse(ctx context.Context, userId int, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateUserSettingsResponse, error) {
+	rsp, err := c.UpdateUserSettingsWithBody(ctx, userId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateUserSettingsResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateUserSettingsWithResponse(ctx context.Context, userId int, body UpdateUserSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateUserSettingsResponse, error) {
+	rsp, err := c.UpdateUserSettings(ctx, userId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateUserSettingsResponse(rsp)
+}
+
+// ParseGetNextTakingResponse parses an HTTP response from a GetNextTakingWithResponse call
+func ParseGetNextTakingResponse(rsp *http.Response) (*GetNextTakingResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetNextTakingResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []NextTakingResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetScheduleResponse parses an HTTP response from a GetScheduleWithResponse call
+func ParseGetScheduleResponse(rsp *http.Response) (*GetScheduleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetScheduleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ScheduleResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostScheduleResponse parses an HTTP response from a PostScheduleWithResponse call
+func ParsePostScheduleResponse(rsp *http.Response) (*PostScheduleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostScheduleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CreateScheduleResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSchedulesResponse parses an HTTP response from a GetSchedulesWithResponse call
+func ParseGetSchedulesResponse(rsp *http.Response) (*GetSchedulesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSchedulesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []int
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostSchedulesImportResponse parses an HTTP response from a PostSchedulesImportWithResponse call
+func ParsePostSchedulesImportResponse(rsp *http.Response) (*PostSchedulesImportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostSchedulesImportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ImportSchedulesResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetSchedulesListResponse parses an HTTP response from a GetSchedulesListWithResponse call
+func ParseGetSchedulesListResponse(rsp *http.Response) (*GetSchedulesListResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetSchedulesListResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SchedulesPageResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetUserDayPlanResponse parses an HTTP response from a GetUserDayPlanWithResponse call
+func ParseGetUserDayPlanResponse(rsp *http.Response) (*GetUserDayPlanResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUserDayPlanResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DayPlanResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePreviewUserDayPlanConsolidationResponse parses an HTTP response from a PreviewUserDayPlanConsolidationWithResponse call
+func ParsePreviewUserDayPlanConsolidationResponse(rsp *http.Response) (*PreviewUserDayPlanConsolidationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PreviewUserDayPlanConsolidationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DayPlanResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetUserNextTakingsResponse parses an HTTP response from a GetUserNextTakingsWithResponse call
+func ParseGetUserNextTakingsResponse(rsp *http.Response) (*GetUserNextTakingsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUserNextTakingsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []NextTakingResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListUserSchedulesResponse parses an HTTP response from a ListUserSchedulesWithResponse call
+func ParseListUserSchedulesResponse(rsp *http.Response) (*ListUserSchedulesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListUserSchedulesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SchedulesPageResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateUserScheduleResponse parses an HTTP response from a CreateUserScheduleWithResponse call
+func ParseCreateUserScheduleResponse(rsp *http.Response) (*CreateUserScheduleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateUserScheduleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CreateScheduleResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePreviewUserScheduleResponse parses an HTTP response from a PreviewUserScheduleWithResponse call
+func ParsePreviewUserScheduleResponse(rsp *http.Response) (*PreviewUserScheduleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PreviewUserScheduleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SchedulePreviewResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteUserScheduleResponse parses an HTTP response from a DeleteUserScheduleWithResponse call
+func ParseDeleteUserScheduleResponse(rsp *http.Response) (*DeleteUserScheduleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+	response := &DeleteUserScheduleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-}
-
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// GetNextTakingWithResponse request
-	GetNextTakingWithResponse(ctx context.Context, params *GetNextTakingParams, reqEditors ...RequestEditorFn) (*GetNextTakingResponse, error)
-
-	// GetScheduleWithResponse request
-	GetScheduleWithResponse(ctx context.Context, params *GetScheduleParams, reqEditors ...RequestEditorFn) (*GetScheduleResponse, error)
-
-	// PostScheduleWithBodyWithResponse request with any body
-	PostScheduleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostScheduleResponse, error)
 
-	PostScheduleWithResponse(ctx context.Context, body PostScheduleJSONRequestBody, reqEditors ...RequestEditorFn) (*PostScheduleResponse, error)
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
 
-	// GetSchedulesWithResponse request
-	GetSchedulesWithResponse(ctx context.Context, params *GetSchedulesParams, reqEditors ...RequestEditorFn) (*GetSchedulesResponse, error)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
 
-type GetNextTakingResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]NextTakingResponse
-	JSON400      *ErrorResponse
-	JSON500      *ErrorResponse
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
 
-// Status returns HTTPResponse.Status
-func (r GetNextTakingResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetNextTakingResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type GetScheduleResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ScheduleResponse
-	JSON400      *ErrorResponse
-	JSON404      *ErrorResponse
-	JSON500      *ErrorResponse
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetScheduleResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseGetUserScheduleResponse parses an HTTP response from a GetUserScheduleWithResponse call
+func ParseGetUserScheduleResponse(rsp *http.Response) (*GetUserScheduleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetScheduleResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &GetUserScheduleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type PostScheduleResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *CreateScheduleResponse
-	JSON400      *ErrorResponse
-	JSON500      *ErrorResponse
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ScheduleResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r PostScheduleResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostScheduleResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
 
-type GetSchedulesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]int
-	JSON400      *ErrorResponse
-	JSON500      *ErrorResponse
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
 
-// Status returns HTTPResponse.Status
-func (r GetSchedulesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetSchedulesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-// GetNextTakingWithResponse request returning *GetNextTakingResponse
-func (c *ClientWithResponses) GetNextTakingWithResponse(ctx context.Context, params *GetNextTakingParams, reqEditors ...RequestEditorFn) (*GetNextTakingResponse, error) {
-	rsp, err := c.GetNextTaking(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetNextTakingResponse(rsp)
+	return response, nil
 }
 
-// GetScheduleWithResponse request returning *GetScheduleResponse
-func (c *ClientWithResponses) GetScheduleWithResponse(ctx context.Context, params *GetScheduleParams, reqEditors ...RequestEditorFn) (*GetScheduleResponse, error) {
-	rsp, err := c.GetSchedule(ctx, params, reqEditors...)
+// ParseUpdateUserScheduleResponse parses an HTTP response from a UpdateUserScheduleWithResponse call
+func ParseUpdateUserScheduleResponse(rsp *http.Response) (*UpdateUserScheduleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetScheduleResponse(rsp)
-}
 
-// PostScheduleWithBodyWithResponse request with arbitrary body returning *PostScheduleResponse
-func (c *ClientWithResponses) PostScheduleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostScheduleResponse, error) {
-	rsp, err := c.PostScheduleWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &UpdateUserScheduleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParsePostScheduleResponse(rsp)
-}
 
-func (c *ClientWithResponses) PostScheduleWithResponse(ctx context.Context, body PostScheduleJSONRequestBody, reqEditors ...RequestEditorFn) (*PostScheduleResponse, error) {
-	rsp, err := c.PostSchedule(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostScheduleResponse(rsp)
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ScheduleResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
 
-// GetSchedulesWithResponse request returning *GetSchedulesResponse
-func (c *ClientWithResponses) GetSchedulesWithResponse(ctx context.Context, params *GetSchedulesParams, reqEditors ...RequestEditorFn) (*GetSchedulesResponse, error) {
-	rsp, err := c.GetSchedules(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
 	}
-	return ParseGetSchedulesResponse(rsp)
+
+	return response, nil
 }
 
-// ParseGetNextTakingResponse parses an HTTP response from a GetNextTakingWithResponse call
-func ParseGetNextTakingResponse(rsp *http.Response) (*GetNextTakingResponse, error) {
+// ParseGetUserScheduleHistoryResponse parses an HTTP response from a GetUserScheduleHistoryWithResponse call
+func ParseGetUserScheduleHistoryResponse(rsp *http.Response) (*GetUserScheduleHistoryResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetNextTakingResponse{
+	response := &GetUserScheduleHistoryResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []NextTakingResponse
+		var dest []AuditRecord
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -621,29 +3431,36 @@ func ParseGetNextTakingResponse(rsp *http.Response) (*GetNextTakingResponse, err
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON500 = &dest
+		response.ApplicationproblemJSON500 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseGetScheduleResponse parses an HTTP response from a GetScheduleWithResponse call
-func ParseGetScheduleResponse(rsp *http.Response) (*GetScheduleResponse, error) {
+// ParseRecordUserScheduleIntakeResponse parses an HTTP response from a RecordUserScheduleIntakeWithResponse call
+func ParseRecordUserScheduleIntakeResponse(rsp *http.Response) (*RecordUserScheduleIntakeResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetScheduleResponse{
+	response := &RecordUserScheduleIntakeResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -661,43 +3478,50 @@ func ParseGetScheduleResponse(rsp *http.Response) (*GetScheduleResponse, error)
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.ApplicationproblemJSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON404 = &dest
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON500 = &dest
+		response.ApplicationproblemJSON500 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParsePostScheduleResponse parses an HTTP response from a PostScheduleWithResponse call
-func ParsePostScheduleResponse(rsp *http.Response) (*PostScheduleResponse, error) {
+// ParseGetUserSettingsResponse parses an HTTP response from a GetUserSettingsWithResponse call
+func ParseGetUserSettingsResponse(rsp *http.Response) (*GetUserSettingsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostScheduleResponse{
+	response := &GetUserSettingsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest CreateScheduleResponse
+		var dest UserSettings
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -708,36 +3532,43 @@ func ParsePostScheduleResponse(rsp *http.Response) (*PostScheduleResponse, error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON500 = &dest
+		response.ApplicationproblemJSON500 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseGetSchedulesResponse parses an HTTP response from a GetSchedulesWithResponse call
-func ParseGetSchedulesResponse(rsp *http.Response) (*GetSchedulesResponse, error) {
+// ParseUpdateUserSettingsResponse parses an HTTP response from a UpdateUserSettingsWithResponse call
+func ParseUpdateUserSettingsResponse(rsp *http.Response) (*UpdateUserSettingsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetSchedulesResponse{
+	response := &UpdateUserSettingsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []int
+		var dest UserSettings
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -748,14 +3579,21 @@ func ParseGetSchedulesResponse(rsp *http.Response) (*GetSchedulesResponse, error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON429 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON500 = &dest
+		response.ApplicationproblemJSON500 = &dest
 
 	}
 
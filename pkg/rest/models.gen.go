@@ -3,6 +3,97 @@
 // Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.4.1 DO NOT EDIT.
 package rest
 
+import (
+	"time"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// Defines values for AuditRecordAction.
+const (
+	Create AuditRecordAction = "create"
+	Delete AuditRecordAction = "delete"
+	Update AuditRecordAction = "update"
+)
+
+// Defines values for ScheduleWarning.
+const (
+	ShortInterval ScheduleWarning = "short_interval"
+)
+
+// Defines values for SlotDecisionReason.
+const (
+	SlotDecisionReasonBeforeAnchor  SlotDecisionReason = "before_anchor"
+	SlotDecisionReasonExpired       SlotDecisionReason = "expired"
+	SlotDecisionReasonNight         SlotDecisionReason = "night"
+	SlotDecisionReasonOutsidePeriod SlotDecisionReason = "outside_period"
+	SlotDecisionReasonPassed        SlotDecisionReason = "passed"
+	SlotDecisionReasonRounded       SlotDecisionReason = "rounded"
+)
+
+// Defines values for PostSchedulesImportParamsMode.
+const (
+	Atomic PostSchedulesImportParamsMode = "atomic"
+	PerRow PostSchedulesImportParamsMode = "per_row"
+)
+
+// Defines values for GetSchedulesListParamsStatus.
+const (
+	GetSchedulesListParamsStatusActive  GetSchedulesListParamsStatus = "active"
+	GetSchedulesListParamsStatusExpired GetSchedulesListParamsStatus = "expired"
+)
+
+// Defines values for GetSchedulesListParamsSort.
+const (
+	GetSchedulesListParamsSortEndAt GetSchedulesListParamsSort = "end_at"
+	GetSchedulesListParamsSortId    GetSchedulesListParamsSort = "id"
+	GetSchedulesListParamsSortName  GetSchedulesListParamsSort = "name"
+)
+
+// Defines values for GetSchedulesListParamsOrder.
+const (
+	GetSchedulesListParamsOrderAsc  GetSchedulesListParamsOrder = "asc"
+	GetSchedulesListParamsOrderDesc GetSchedulesListParamsOrder = "desc"
+)
+
+// Defines values for ListUserSchedulesParamsStatus.
+const (
+	Active  ListUserSchedulesParamsStatus = "active"
+	Expired ListUserSchedulesParamsStatus = "expired"
+)
+
+// Defines values for ListUserSchedulesParamsSort.
+const (
+	ListUserSchedulesParamsSortEndAt ListUserSchedulesParamsSort = "end_at"
+	ListUserSchedulesParamsSortId    ListUserSchedulesParamsSort = "id"
+	ListUserSchedulesParamsSortName  ListUserSchedulesParamsSort = "name"
+)
+
+// Defines values for ListUserSchedulesParamsOrder.
+const (
+	ListUserSchedulesParamsOrderAsc  ListUserSchedulesParamsOrder = "asc"
+	ListUserSchedulesParamsOrderDesc ListUserSchedulesParamsOrder = "desc"
+)
+
+// AuditRecord defines model for audit_record.
+type AuditRecord struct {
+	Action AuditRecordAction `json:"action"`
+
+	// Actor authenticated client certificate or anonymous
+	Actor  string            `json:"actor"`
+	After  *ScheduleSnapshot `json:"after,omitempty"`
+	Before *ScheduleSnapshot `json:"before,omitempty"`
+
+	// ClaimedActor X-Actor header, it is not verified, not set if header is absent
+	ClaimedActor *string   `json:"claimed_actor,omitempty"`
+	CreatedAt    time.Time `json:"created_at"`
+	Id           int64     `json:"id"`
+	TraceId      string    `json:"trace_id"`
+}
+
+// AuditRecordAction defines model for AuditRecord.Action.
+type AuditRecordAction string
+
 // CreateScheduleRequest defines model for create_schedule_request.
 type CreateScheduleRequest struct {
 	// Duration days
@@ -15,29 +106,271 @@ type CreateScheduleRequest struct {
 // CreateScheduleResponse defines model for create_schedule_response.
 type CreateScheduleResponse struct {
 	Id int `json:"id"`
+
+	// Warnings problems of schedule which do not prevent saving it
+	Warnings *[]ScheduleWarning `json:"warnings,omitempty"`
+}
+
+// CreateUserScheduleRequest defines model for create_user_schedule_request.
+type CreateUserScheduleRequest struct {
+	// AnchorAt time of first taking, takings are stepped from it, at most about a year from now
+	AnchorAt *time.Time `json:"anchor_at,omitempty"`
+
+	// ConsolidationTolerance max shift of dose when reminders are consolidated, limited by quarter of period, at most 6h, default of service if not set
+	ConsolidationTolerance *string `json:"consolidation_tolerance,omitempty"`
+
+	// Doses number of takings, course ends with the last of them instead of duration
+	Doses *int `json:"doses,omitempty"`
+
+	// Duration days, 0 if course is limited by doses
+	Duration int    `json:"duration"`
+	Name     string `json:"name"`
+
+	// Period empty if times_per_day is set
+	Period string `json:"period"`
+
+	// Reanchor intake later than nearest taking moves anchor
+	Reanchor *bool `json:"reanchor,omitempty"`
+
+	// RoundTheClock takings continue through night, counted from anchor or begin of first day
+	RoundTheClock *bool `json:"round_the_clock,omitempty"`
+
+	// TimesPerDay takings are spread evenly over day instead of period
+	TimesPerDay *int `json:"times_per_day,omitempty"`
 }
 
-// ErrorResponse defines model for error_response.
+// DayPlanDose defines model for day_plan_dose.
+type DayPlanDose struct {
+	// Late intake is later than prescribed time by more than late dose tolerance
+	Late bool   `json:"late"`
+	Name string `json:"name"`
+
+	// PrescribedTime time by schedule, set if dose is moved to slot at other time
+	PrescribedTime *string `json:"prescribed_time,omitempty"`
+	ScheduleId     int     `json:"schedule_id"`
+
+	// TakenAt time of recorded intake matched to dose by nearest prescribed time, not set if dose is not taken
+	TakenAt *time.Time `json:"taken_at,omitempty"`
+}
+
+// DayPlanResponse defines model for day_plan_response.
+type DayPlanResponse struct {
+	// Consolidated doses are moved to shared slots
+	Consolidated bool               `json:"consolidated"`
+	Date         openapi_types.Date `json:"date"`
+	Slots        []DayPlanSlot      `json:"slots"`
+}
+
+// DayPlanSlot defines model for day_plan_slot.
+type DayPlanSlot struct {
+	Doses []DayPlanDose `json:"doses"`
+
+	// Night slot of round-the-clock schedules outside of day window
+	Night bool   `json:"night"`
+	Time  string `json:"time"`
+}
+
+// ErrorResponse RFC 7807 problem details
 type ErrorResponse struct {
+	Detail *string `json:"detail,omitempty"`
+
+	// Error same as reason, kept for compatibility
 	Error string `json:"error"`
+
+	// Reason machine-readable reason
+	Reason     string            `json:"reason"`
+	Status     int               `json:"status"`
+	Title      string            `json:"title"`
+	TraceId    *string           `json:"trace_id,omitempty"`
+	Type       string            `json:"type"`
+	Violations *[]FieldViolation `json:"violations,omitempty"`
+}
+
+// FieldViolation defines model for field_violation.
+type FieldViolation struct {
+	Description string `json:"description"`
+	Field       string `json:"field"`
+}
+
+// ImportScheduleRow defines model for import_schedule_row.
+type ImportScheduleRow struct {
+	Error *string `json:"error,omitempty"`
+	Id    *int    `json:"id,omitempty"`
+	Line  int     `json:"line"`
+}
+
+// ImportSchedulesResponse defines model for import_schedules_response.
+type ImportSchedulesResponse struct {
+	Created int                 `json:"created"`
+	Failed  int                 `json:"failed"`
+	Rows    []ImportScheduleRow `json:"rows"`
 }
 
 // NextTakingResponse defines model for next_taking_response.
 type NextTakingResponse struct {
-	EndAt      *string `json:"end_at,omitempty"`
-	Id         int     `json:"id"`
-	Name       string  `json:"name"`
-	NextTaking string  `json:"next_taking"`
-	Period     string  `json:"period"`
+	EndAt *string `json:"end_at,omitempty"`
+
+	// Explanation decisions about candidate takings of schedule, set only with explain
+	Explanation *[]SlotDecision `json:"explanation,omitempty"`
+	Id          int             `json:"id"`
+	Name        string          `json:"name"`
+	NextTaking  string          `json:"next_taking"`
+
+	// Night taking of round-the-clock schedule outside of day window
+	Night  bool   `json:"night"`
+	Period string `json:"period"`
+}
+
+// RecordIntakeRequest defines model for record_intake_request.
+type RecordIntakeRequest struct {
+	// TakenAt time of intake, now if not set
+	TakenAt *time.Time `json:"taken_at,omitempty"`
+}
+
+// ScheduleItem defines model for schedule_item.
+type ScheduleItem struct {
+	EndAt         *string `json:"end_at,omitempty"`
+	Id            int     `json:"id"`
+	Name          string  `json:"name"`
+	Period        string  `json:"period"`
+	RoundTheClock bool    `json:"round_the_clock"`
+
+	// TimesPerDay number of fixed takings of day, not set if takings are stepped by period
+	TimesPerDay *int `json:"times_per_day,omitempty"`
+}
+
+// SchedulePreviewDay defines model for schedule_preview_day.
+type SchedulePreviewDay struct {
+	Date openapi_types.Date `json:"date"`
+
+	// NightSlots takings of timetable outside of day window
+	NightSlots *[]string `json:"night_slots,omitempty"`
+	Timetable  []string  `json:"timetable"`
+}
+
+// SchedulePreviewResponse defines model for schedule_preview_response.
+type SchedulePreviewResponse struct {
+	Days  []SchedulePreviewDay `json:"days"`
+	EndAt *string              `json:"end_at,omitempty"`
+
+	// FinalDoseAt last taking of course limited by number of doses, not set if course is not limited by doses
+	FinalDoseAt *time.Time `json:"final_dose_at,omitempty"`
+	Name        string     `json:"name"`
+	NextTakings []string   `json:"next_takings"`
+	Period      string     `json:"period"`
+
+	// RemainingDoses takings left to the final dose, not set if course is not limited by doses
+	RemainingDoses *int `json:"remaining_doses,omitempty"`
+	RoundTheClock  bool `json:"round_the_clock"`
+
+	// TimesPerDay number of fixed takings of day, not set if takings are stepped by period
+	TimesPerDay *int `json:"times_per_day,omitempty"`
+
+	// Warnings problems of schedule which do not prevent saving it
+	Warnings *[]ScheduleWarning `json:"warnings,omitempty"`
 }
 
 // ScheduleResponse defines model for schedule_response.
 type ScheduleResponse struct {
-	EndAt     *string  `json:"end_at,omitempty"`
-	Id        int      `json:"id"`
-	Name      string   `json:"name"`
-	Period    string   `json:"period"`
-	Timetable []string `json:"timetable"`
+	// AnchorAt takings are stepped from it, not set if takings start at begin of each day
+	AnchorAt *time.Time `json:"anchor_at,omitempty"`
+
+	// ConsolidationTolerance max shift of dose when reminders are consolidated, not set if default of service is used
+	ConsolidationTolerance *string `json:"consolidation_tolerance,omitempty"`
+	EndAt                  *string `json:"end_at,omitempty"`
+
+	// Explanation decisions about candidate takings, set only with explain
+	Explanation *[]SlotDecision `json:"explanation,omitempty"`
+
+	// FinalDoseAt last taking of course limited by number of doses, not set if course is not limited by doses
+	FinalDoseAt *time.Time `json:"final_dose_at,omitempty"`
+	Id          int        `json:"id"`
+	Name        string     `json:"name"`
+
+	// NightSlots takings of timetable outside of day window
+	NightSlots *[]string `json:"night_slots,omitempty"`
+	Period     string    `json:"period"`
+	Reanchor   bool      `json:"reanchor"`
+
+	// RemainingDoses takings left to the final dose, not set if course is not limited by doses
+	RemainingDoses *int `json:"remaining_doses,omitempty"`
+	RoundTheClock  bool `json:"round_the_clock"`
+
+	// TimesPerDay number of fixed takings of day, not set if takings are stepped by period
+	TimesPerDay *int     `json:"times_per_day,omitempty"`
+	Timetable   []string `json:"timetable"`
+
+	// Warnings problems of schedule which do not prevent saving it
+	Warnings *[]ScheduleWarning `json:"warnings,omitempty"`
+}
+
+// ScheduleSnapshot defines model for schedule_snapshot.
+type ScheduleSnapshot struct {
+	AnchorAt               *time.Time `json:"anchor_at,omitempty"`
+	ConsolidationTolerance *string    `json:"consolidation_tolerance,omitempty"`
+	DaySlots               *[]string  `json:"day_slots,omitempty"`
+	EndAt                  *string    `json:"end_at,omitempty"`
+	FinalDoseAt            *time.Time `json:"final_dose_at,omitempty"`
+	Name                   string     `json:"name"`
+	Period                 string     `json:"period"`
+	Reanchor               *bool      `json:"reanchor,omitempty"`
+	RoundTheClock          *bool      `json:"round_the_clock,omitempty"`
+}
+
+// ScheduleWarning short_interval - interval between takings is shorter than 1 hour
+type ScheduleWarning string
+
+// SchedulesPageResponse defines model for schedules_page_response.
+type SchedulesPageResponse struct {
+	Items      []ScheduleItem `json:"items"`
+	NextCursor *string        `json:"next_cursor,omitempty"`
+}
+
+// SlotDecision defines model for slot_decision.
+type SlotDecision struct {
+	Kept bool `json:"kept"`
+
+	// Reason why slot was rejected, not set if slot was kept
+	Reason     *SlotDecisionReason `json:"reason,omitempty"`
+	ScheduleId int                 `json:"schedule_id"`
+	Time       string              `json:"time"`
+}
+
+// SlotDecisionReason why slot was rejected, not set if slot was kept
+type SlotDecisionReason string
+
+// UpdateScheduleRequest missing fields are not changed
+type UpdateScheduleRequest struct {
+	// AnchorAt time of first taking, takings are stepped from it, at most about a year from now
+	AnchorAt *time.Time `json:"anchor_at,omitempty"`
+
+	// ConsolidationTolerance max shift of dose when reminders are consolidated, limited by quarter of period, at most 6h, 0s returns to default of service
+	ConsolidationTolerance *string `json:"consolidation_tolerance,omitempty"`
+
+	// Doses takings from now, replaces duration, 0 removes limit
+	Doses *int `json:"doses,omitempty"`
+
+	// Duration days from now, 0 removes end date
+	Duration *int    `json:"duration,omitempty"`
+	Name     *string `json:"name,omitempty"`
+
+	// Period replaces times per day
+	Period *string `json:"period,omitempty"`
+
+	// Reanchor intake later than nearest taking moves anchor
+	Reanchor *bool `json:"reanchor,omitempty"`
+
+	// RoundTheClock takings continue through night
+	RoundTheClock *bool `json:"round_the_clock,omitempty"`
+
+	// TimesPerDay replaces period, takings are spread evenly over day
+	TimesPerDay *int `json:"times_per_day,omitempty"`
+}
+
+// UserSettings defines model for user_settings.
+type UserSettings struct {
+	// ConsolidateReminders move doses of different schedules to shared slots within tolerance
+	ConsolidateReminders bool `json:"consolidate_reminders"`
 }
 
 // GetNextTakingParams defines parameters for GetNextTaking.
@@ -45,6 +378,9 @@ type GetNextTakingParams struct {
 	// UserId user id
 	UserId int `form:"user_id" json:"user_id"`
 
+	// Explain explain why each candidate taking is kept or rejected
+	Explain *bool `form:"explain,omitempty" json:"explain,omitempty"`
+
 	// TZ timezone
 	TZ *string `json:"TZ,omitempty"`
 }
@@ -57,6 +393,9 @@ type GetScheduleParams struct {
 	// ScheduleId schedule id
 	ScheduleId int `form:"schedule_id" json:"schedule_id"`
 
+	// Explain explain why each candidate taking is kept or rejected
+	Explain *bool `form:"explain,omitempty" json:"explain,omitempty"`
+
 	// TZ timezone
 	TZ *string `json:"TZ,omitempty"`
 }
@@ -70,5 +409,167 @@ type GetSchedulesParams struct {
 	TZ *string `json:"TZ,omitempty"`
 }
 
+// PostSchedulesImportParams defines parameters for PostSchedulesImport.
+type PostSchedulesImportParams struct {
+	// Mode atomic - all rows in single transaction, per_row - each valid row saved separately
+	Mode *PostSchedulesImportParamsMode `form:"mode,omitempty" json:"mode,omitempty"`
+}
+
+// PostSchedulesImportParamsMode defines parameters for PostSchedulesImport.
+type PostSchedulesImportParamsMode string
+
+// GetSchedulesListParams defines parameters for GetSchedulesList.
+type GetSchedulesListParams struct {
+	// UserId user id
+	UserId int `form:"user_id" json:"user_id"`
+
+	// Name name substring
+	Name *string `form:"name,omitempty" json:"name,omitempty"`
+
+	// Status schedule status
+	Status *GetSchedulesListParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// EndAtFrom min end date
+	EndAtFrom *openapi_types.Date `form:"end_at_from,omitempty" json:"end_at_from,omitempty"`
+
+	// EndAtTo max end date
+	EndAtTo *openapi_types.Date `form:"end_at_to,omitempty" json:"end_at_to,omitempty"`
+
+	// Sort sort field
+	Sort *GetSchedulesListParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Order sort order
+	Order *GetSchedulesListParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+
+	// Cursor next_cursor from previous page, valid only with same filters, sort and order
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Limit page size
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// TZ timezone
+	TZ *string `json:"TZ,omitempty"`
+}
+
+// GetSchedulesListParamsStatus defines parameters for GetSchedulesList.
+type GetSchedulesListParamsStatus string
+
+// GetSchedulesListParamsSort defines parameters for GetSchedulesList.
+type GetSchedulesListParamsSort string
+
+// GetSchedulesListParamsOrder defines parameters for GetSchedulesList.
+type GetSchedulesListParamsOrder string
+
+// GetUserDayPlanParams defines parameters for GetUserDayPlan.
+type GetUserDayPlanParams struct {
+	// Date day in user timezone, today if not set
+	Date *openapi_types.Date `form:"date,omitempty" json:"date,omitempty"`
+
+	// TZ timezone
+	TZ *string `json:"TZ,omitempty"`
+}
+
+// PreviewUserDayPlanConsolidationParams defines parameters for PreviewUserDayPlanConsolidation.
+type PreviewUserDayPlanConsolidationParams struct {
+	// Date day in user timezone, today if not set
+	Date *openapi_types.Date `form:"date,omitempty" json:"date,omitempty"`
+
+	// TZ timezone
+	TZ *string `json:"TZ,omitempty"`
+}
+
+// GetUserNextTakingsParams defines parameters for GetUserNextTakings.
+type GetUserNextTakingsParams struct {
+	// Explain explain why each candidate taking is kept or rejected
+	Explain *bool `form:"explain,omitempty" json:"explain,omitempty"`
+
+	// TZ timezone
+	TZ *string `json:"TZ,omitempty"`
+}
+
+// ListUserSchedulesParams defines parameters for ListUserSchedules.
+type ListUserSchedulesParams struct {
+	// Name name substring
+	Name *string `form:"name,omitempty" json:"name,omitempty"`
+
+	// Status schedule status
+	Status *ListUserSchedulesParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// EndAtFrom min end date
+	EndAtFrom *openapi_types.Date `form:"end_at_from,omitempty" json:"end_at_from,omitempty"`
+
+	// EndAtTo max end date
+	EndAtTo *openapi_types.Date `form:"end_at_to,omitempty" json:"end_at_to,omitempty"`
+
+	// Sort sort field
+	Sort *ListUserSchedulesParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Order sort order
+	Order *ListUserSchedulesParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+
+	// Cursor next_cursor from previous page, valid only with same filters, sort and order
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Limit page size
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// TZ timezone
+	TZ *string `json:"TZ,omitempty"`
+}
+
+// ListUserSchedulesParamsStatus defines parameters for ListUserSchedules.
+type ListUserSchedulesParamsStatus string
+
+// ListUserSchedulesParamsSort defines parameters for ListUserSchedules.
+type ListUserSchedulesParamsSort string
+
+// ListUserSchedulesParamsOrder defines parameters for ListUserSchedules.
+type ListUserSchedulesParamsOrder string
+
+// PreviewUserScheduleParams defines parameters for PreviewUserSchedule.
+type PreviewUserScheduleParams struct {
+	// Days number of days since today
+	Days *int `form:"days,omitempty" json:"days,omitempty"`
+
+	// TZ timezone
+	TZ *string `json:"TZ,omitempty"`
+}
+
+// GetUserScheduleParams defines parameters for GetUserSchedule.
+type GetUserScheduleParams struct {
+	// Explain explain why each candidate taking is kept or rejected
+	Explain *bool `form:"explain,omitempty" json:"explain,omitempty"`
+
+	// TZ timezone
+	TZ *string `json:"TZ,omitempty"`
+}
+
+// UpdateUserScheduleParams defines parameters for UpdateUserSchedule.
+type UpdateUserScheduleParams struct {
+	// TZ timezone
+	TZ *string `json:"TZ,omitempty"`
+}
+
+// RecordUserScheduleIntakeParams defines parameters for RecordUserScheduleIntake.
+type RecordUserScheduleIntakeParams struct {
+	// TZ timezone
+	TZ *string `json:"TZ,omitempty"`
+}
+
 // PostScheduleJSONRequestBody defines body for PostSchedule for application/json ContentType.
 type PostScheduleJSONRequestBody = CreateScheduleRequest
+
+// CreateUserScheduleJSONRequestBody defines body for CreateUserSchedule for application/json ContentType.
+type CreateUserScheduleJSONRequestBody = CreateUserScheduleRequest
+
+// PreviewUserScheduleJSONRequestBody defines body for PreviewUserSchedule for application/json ContentType.
+type PreviewUserScheduleJSONRequestBody = CreateUserScheduleRequest
+
+// UpdateUserScheduleJSONRequestBody defines body for UpdateUserSchedule for application/json ContentType.
+type UpdateUserScheduleJSONRequestBody = UpdateScheduleRequest
+
+// RecordUserScheduleIntakeJSONRequestBody defines body for RecordUserScheduleIntake for application/json ContentType.
+type RecordUserScheduleIntakeJSONRequestBody = RecordIntakeRequest
+
+// UpdateUserSettingsJSONRequestBody defines body for UpdateUserSettings for application/json ContentType.
+type UpdateUserSettingsJSONRequestBody = UserSettings
@@ -0,0 +1,25 @@
+package tlsx
+
+import (
+	"crypto/tls"
+	"schedule/pkg/contextx"
+)
+
+// ClientIdentity returns identity of verified client certificate, nil if client has no verified certificate.
+func ClientIdentity(state *tls.ConnectionState) *contextx.ClientIdentity {
+	if state == nil || len(state.VerifiedChains) == 0 || len(state.VerifiedChains[0]) == 0 {
+		return nil
+	}
+
+	cert := state.VerifiedChains[0][0]
+
+	identity := &contextx.ClientIdentity{
+		CommonName: cert.Subject.CommonName,
+		DNSNames:   cert.DNSNames,
+	}
+	for _, uri := range cert.URIs {
+		identity.URIs = append(identity.URIs, uri.String())
+	}
+
+	return identity
+}
@@ -0,0 +1,150 @@
+// Package tlsx makes server tls config with certificates reloaded when files change.
+package tlsx
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"schedule/pkg/contextx"
+	"sync/atomic"
+	"time"
+)
+
+// Reloader keeps server certificate and client CA pool, files are reloaded by Watch.
+type Reloader struct {
+	certFile     string
+	keyFile      string
+	clientCAFile string // empty if client certificates are not required
+
+	cert      atomic.Pointer[tls.Certificate]
+	clientCAs atomic.Pointer[x509.CertPool]
+	modTimes  []time.Time
+}
+
+func NewReloader(certFile, keyFile, clientCAFile string) (*Reloader, error) {
+	r := &Reloader{
+		certFile:     certFile,
+		keyFile:      keyFile,
+		clientCAFile: clientCAFile,
+	}
+
+	if err := r.reload(); err != nil {
+		return nil, err
+	}
+	r.modTimes, _ = r.readModTimes()
+
+	return r, nil
+}
+
+// TLSConfig returns config which uses current certificates for each handshake.
+// Client certificate is required and verified if client CA is set.
+func (r *Reloader) TLSConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			cfg := &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				Certificates: []tls.Certificate{*r.cert.Load()},
+				NextProtos:   []string{"h2", "http/1.1"}, // config for client replaces protocols set by http and grpc servers
+			}
+			if clientCAs := r.clientCAs.Load(); clientCAs != nil {
+				cfg.ClientCAs = clientCAs
+				cfg.ClientAuth = tls.RequireAndVerifyClientCert
+			}
+			return cfg, nil
+		},
+	}
+}
+
+// Watch checks modification time of files with interval until ctx is done.
+// Certificates are kept if new files are invalid, e.g. while they are partially written.
+func (r *Reloader) Watch(ctx context.Context, interval time.Duration) {
+	l := contextx.GetLoggerOrDefault(ctx)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		modTimes, err := r.readModTimes()
+		if err != nil {
+			l.LogAttrs(ctx, slog.LevelWarn, "stat certificate files error", slog.String("err", err.Error()))
+			continue
+		}
+		if equalTimes(modTimes, r.modTimes) {
+			continue
+		}
+
+		if err := r.reload(); err != nil {
+			l.LogAttrs(ctx, slog.LevelWarn, "reload certificates error", slog.String("err", err.Error()))
+			continue
+		}
+		r.modTimes = modTimes
+
+		l.LogAttrs(ctx, slog.LevelInfo, "certificates reloaded", slog.String("cert_file", r.certFile))
+	}
+}
+
+func (r *Reloader) reload() error {
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return fmt.Errorf("load certificate: %w", err)
+	}
+
+	var clientCAs *x509.CertPool
+	if r.clientCAFile != "" {
+		pem, err := os.ReadFile(r.clientCAFile)
+		if err != nil {
+			return fmt.Errorf("read client ca: %w", err)
+		}
+
+		clientCAs = x509.NewCertPool()
+		if !clientCAs.AppendCertsFromPEM(pem) {
+			return errors.New("client ca file contains no certificates")
+		}
+	}
+
+	r.cert.Store(&cert)
+	r.clientCAs.Store(clientCAs)
+
+	return nil
+}
+
+func (r *Reloader) readModTimes() ([]time.Time, error) {
+	files := []string{r.certFile, r.keyFile}
+	if r.clientCAFile != "" {
+		files = append(files, r.clientCAFile)
+	}
+
+	modTimes := make([]time.Time, len(files))
+	for i, file := range files {
+		info, err := os.Stat(file)
+		if err != nil {
+			return nil, err
+		}
+		modTimes[i] = info.ModTime()
+	}
+
+	return modTimes, nil
+}
+
+func equalTimes(a, b []time.Time) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].Equal(b[i]) {
+			return false
+		}
+	}
+	return true
+}
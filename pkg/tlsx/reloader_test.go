@@ -0,0 +1,149 @@
+package tlsx
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"github.com/stretchr/testify/require"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+type testCert struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+func newTestCert(t *testing.T, cn string, parent *testCert, usage x509.ExtKeyUsage) *testCert {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	serial, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	require.NoError(t, err)
+
+	tmpl := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: cn},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+	}
+	if parent == nil {
+		tmpl.IsCA = true
+		tmpl.BasicConstraintsValid = true
+	} else {
+		tmpl.ExtKeyUsage = []x509.ExtKeyUsage{usage}
+		tmpl.DNSNames = []string{cn}
+		tmpl.IPAddresses = []net.IP{net.IPv4(127, 0, 0, 1)}
+	}
+
+	parentCert, parentKey := tmpl, key
+	if parent != nil {
+		parentCert, parentKey = parent.cert, parent.key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, parentCert, &key.PublicKey, parentKey)
+	require.NoError(t, err)
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return &testCert{
+		cert: cert,
+		key:  key,
+		pem:  pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+	}
+}
+
+func (c *testCert) keyPEM(t *testing.T) []byte {
+	der, err := x509.MarshalECPrivateKey(c.key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der})
+}
+
+func (c *testCert) tlsCertificate(t *testing.T) tls.Certificate {
+	cert, err := tls.X509KeyPair(c.pem, c.keyPEM(t))
+	require.NoError(t, err)
+	return cert
+}
+
+func TestReloader(t *testing.T) {
+	dir := t.TempDir()
+	certFile := filepath.Join(dir, "server.crt")
+	keyFile := filepath.Join(dir, "server.key")
+	caFile := filepath.Join(dir, "ca.crt")
+
+	ca := newTestCert(t, "test ca", nil, 0)
+	client := newTestCert(t, "client-service", ca, x509.ExtKeyUsageClientAuth)
+
+	writeServerCert := func(cn string) {
+		server := newTestCert(t, cn, ca, x509.ExtKeyUsageServerAuth)
+		require.NoError(t, os.WriteFile(certFile, server.pem, 0o600))
+		require.NoError(t, os.WriteFile(keyFile, server.keyPEM(t), 0o600))
+	}
+
+	writeServerCert("server-1")
+	require.NoError(t, os.WriteFile(caFile, ca.pem, 0o600))
+
+	reloader, err := NewReloader(certFile, keyFile, caFile)
+	require.NoError(t, err)
+
+	var identity string
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if id := ClientIdentity(r.TLS); id != nil {
+			identity = id.CommonName
+		}
+	}))
+	srv.TLS = reloader.TLSConfig()
+	srv.StartTLS()
+	defer srv.Close()
+
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.cert)
+
+	get := func(clientCerts ...tls.Certificate) (string, error) {
+		httpClient := &http.Client{Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{RootCAs: roots, Certificates: clientCerts},
+		}}
+		resp, err := httpClient.Get(srv.URL)
+		if err != nil {
+			return "", err
+		}
+		resp.Body.Close()
+		return resp.TLS.PeerCertificates[0].Subject.CommonName, nil
+	}
+
+	serverName, err := get(client.tlsCertificate(t))
+	require.NoError(t, err)
+	require.Equal(t, "server-1", serverName)
+	require.Equal(t, "client-service", identity)
+
+	_, err = get()
+	require.Error(t, err, "client certificate is required")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go reloader.Watch(ctx, 10*time.Millisecond)
+
+	time.Sleep(20 * time.Millisecond) // modification time must differ
+	writeServerCert("server-2")
+
+	require.Eventually(t, func() bool {
+		serverName, err := get(client.tlsCertificate(t))
+		return err == nil && serverName == "server-2"
+	}, 2*time.Second, 20*time.Millisecond)
+}
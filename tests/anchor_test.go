@@ -0,0 +1,98 @@
+package tests
+
+import (
+	"context"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"net/http"
+	"schedule/internal/util"
+	schedulev2 "schedule/pkg/grpc/v2"
+	"schedule/pkg/rest"
+	"time"
+)
+
+func (s *Suite) TestAnchorHTTP() {
+	const (
+		userId = 1000000000000007
+	)
+
+	rq := s.Require()
+	ctx := context.Background()
+
+	created, err := s.httpClient.CreateUserScheduleWithResponse(ctx, userId, rest.CreateUserScheduleRequest{
+		Name:     "Test anchor",
+		Period:   "5h",
+		Reanchor: util.Ptr(true),
+	})
+	rq.NoError(err)
+	rq.Equal(http.StatusCreated, created.StatusCode(), string(created.Body))
+
+	scheduleId := created.JSON201.Id
+
+	schedule, err := s.httpClient.GetUserScheduleWithResponse(ctx, userId, scheduleId, &rest.GetUserScheduleParams{})
+	rq.NoError(err)
+	rq.Equal(http.StatusOK, schedule.StatusCode(), string(schedule.Body))
+	rq.Nil(schedule.JSON200.AnchorAt)
+	rq.True(schedule.JSON200.Reanchor)
+	rq.Equal([]string{"08:00:00", "13:00:00", "18:00:00"}, schedule.JSON200.Timetable)
+
+	firstIntake := time.Date(2025, time.January, 1, 10, 30, 0, 0, time.UTC)
+	intake, err := s.httpClient.RecordUserScheduleIntakeWithResponse(ctx, userId, scheduleId, &rest.RecordUserScheduleIntakeParams{}, rest.RecordIntakeRequest{
+		TakenAt: &firstIntake,
+	})
+	rq.NoError(err)
+	rq.Equal(http.StatusOK, intake.StatusCode(), string(intake.Body))
+	rq.Equal(firstIntake, intake.JSON200.AnchorAt.UTC())
+	rq.Equal([]string{"10:30:00", "15:30:00", "20:30:00"}, intake.JSON200.Timetable)
+
+	intake, err = s.httpClient.RecordUserScheduleIntakeWithResponse(ctx, userId, scheduleId, &rest.RecordUserScheduleIntakeParams{}, rest.RecordIntakeRequest{})
+	rq.NoError(err)
+	rq.Equal(http.StatusOK, intake.StatusCode(), string(intake.Body))
+	rq.Equal([]string{"12:00:00", "17:00:00", "22:00:00"}, intake.JSON200.Timetable, "late intake moves anchor")
+
+	future := time.Date(2025, time.January, 1, 13, 0, 0, 0, time.UTC)
+	intake, err = s.httpClient.RecordUserScheduleIntakeWithResponse(ctx, userId, scheduleId, &rest.RecordUserScheduleIntakeParams{}, rest.RecordIntakeRequest{
+		TakenAt: &future,
+	})
+	rq.NoError(err)
+	rq.Equal(http.StatusBadRequest, intake.StatusCode(), string(intake.Body))
+
+	anchor := time.Date(2025, time.January, 1, 9, 0, 0, 0, time.UTC)
+	updated, err := s.httpClient.UpdateUserScheduleWithResponse(ctx, userId, scheduleId, &rest.UpdateUserScheduleParams{}, rest.UpdateScheduleRequest{
+		AnchorAt: &anchor,
+		Reanchor: util.Ptr(false),
+	})
+	rq.NoError(err)
+	rq.Equal(http.StatusOK, updated.StatusCode(), string(updated.Body))
+	rq.False(updated.JSON200.Reanchor)
+	rq.Equal([]string{"09:00:00", "14:00:00", "19:00:00"}, updated.JSON200.Timetable)
+}
+
+func (s *Suite) TestAnchorGRPCV2() {
+	const (
+		userId = 1000000000000007
+	)
+
+	rq := s.Require()
+	ctx := context.Background()
+
+	anchor := time.Date(2025, time.January, 1, 9, 0, 0, 0, time.UTC)
+	created, err := s.grpcClientV2.CreateSchedule(ctx, &schedulev2.CreateScheduleRequest{
+		UserId:     userId,
+		Name:       "Test anchor",
+		Period:     durationpb.New(time.Hour * 5),
+		AnchorTime: timestamppb.New(anchor),
+	})
+	rq.NoError(err)
+	rq.Equal(anchor, created.GetAnchorTime().AsTime())
+	rq.Len(created.GetTimetable(), 3)
+	rq.Equal(anchor, created.GetTimetable()[0].AsTime())
+
+	intake, err := s.grpcClientV2.RecordIntake(ctx, &schedulev2.RecordIntakeRequest{
+		UserId:     userId,
+		ScheduleId: created.GetId(),
+		TakenTime:  timestamppb.New(anchor.Add(time.Hour)),
+	})
+	rq.NoError(err)
+	rq.Equal(anchor, intake.GetAnchorTime().AsTime(), "anchor is kept without reanchor")
+}
@@ -0,0 +1,92 @@
+package tests
+
+import (
+	"context"
+	"net/http"
+	"schedule/internal/util"
+	schedulev2 "schedule/pkg/grpc/v2"
+	"schedule/pkg/rest"
+)
+
+func (s *Suite) TestReminderConsolidation() {
+	const (
+		userId = 1000000000000005
+	)
+
+	rq := s.Require()
+	ctx := context.Background()
+
+	ids := make([]int, 0, 2)
+	for _, req := range []rest.CreateUserScheduleRequest{
+		{Name: "Test consolidation A", Period: "5h"},
+		{Name: "Test consolidation B", Period: "4h30m"},
+	} {
+		created, err := s.httpClient.CreateUserScheduleWithResponse(ctx, userId, req)
+		rq.NoError(err)
+		rq.Equal(http.StatusCreated, created.StatusCode(), string(created.Body))
+		ids = append(ids, created.JSON201.Id)
+	}
+
+	settings, err := s.httpClient.GetUserSettingsWithResponse(ctx, userId)
+	rq.NoError(err)
+	rq.Equal(http.StatusOK, settings.StatusCode(), string(settings.Body))
+	rq.False(settings.JSON200.ConsolidateReminders)
+
+	plan, err := s.httpClient.GetUserDayPlanWithResponse(ctx, userId, &rest.GetUserDayPlanParams{})
+	rq.NoError(err)
+	rq.Equal(http.StatusOK, plan.StatusCode(), string(plan.Body))
+	rq.False(plan.JSON200.Consolidated)
+	rq.Len(plan.JSON200.Slots, 6)
+
+	consolidated := []rest.DayPlanSlot{
+		{Time: "08:00:00", Doses: []rest.DayPlanDose{
+			{ScheduleId: ids[0], Name: "Test consolidation A"},
+			{ScheduleId: ids[1], Name: "Test consolidation B"},
+		}},
+		{Time: "12:30:00", Doses: []rest.DayPlanDose{
+			{ScheduleId: ids[0], Name: "Test consolidation A", PrescribedTime: util.Ptr("13:00:00")},
+			{ScheduleId: ids[1], Name: "Test consolidation B"},
+		}},
+		{Time: "17:30:00", Doses: []rest.DayPlanDose{
+			{ScheduleId: ids[0], Name: "Test consolidation A", PrescribedTime: util.Ptr("18:00:00")},
+			{ScheduleId: ids[1], Name: "Test consolidation B", PrescribedTime: util.Ptr("17:00:00")},
+		}},
+		{Time: "21:30:00", Doses: []rest.DayPlanDose{
+			{ScheduleId: ids[1], Name: "Test consolidation B"},
+		}},
+	}
+
+	preview, err := s.httpClient.PreviewUserDayPlanConsolidationWithResponse(ctx, userId, &rest.PreviewUserDayPlanConsolidationParams{})
+	rq.NoError(err)
+	rq.Equal(http.StatusOK, preview.StatusCode(), string(preview.Body))
+	rq.True(preview.JSON200.Consolidated)
+	rq.Equal(consolidated, preview.JSON200.Slots)
+
+	updated, err := s.httpClient.UpdateUserSettingsWithResponse(ctx, userId, rest.UserSettings{ConsolidateReminders: true})
+	rq.NoError(err)
+	rq.Equal(http.StatusOK, updated.StatusCode(), string(updated.Body))
+	rq.True(updated.JSON200.ConsolidateReminders)
+
+	plan, err = s.httpClient.GetUserDayPlanWithResponse(ctx, userId, &rest.GetUserDayPlanParams{})
+	rq.NoError(err)
+	rq.Equal(http.StatusOK, plan.StatusCode(), string(plan.Body))
+	rq.True(plan.JSON200.Consolidated)
+	rq.Equal(consolidated, plan.JSON200.Slots)
+
+	grpcSettings, err := s.grpcClientV2.GetUserSettings(ctx, &schedulev2.GetUserSettingsRequest{UserId: userId})
+	rq.NoError(err)
+	rq.True(grpcSettings.GetConsolidateReminders())
+
+	_, err = s.grpcClientV2.UpdateUserSettings(ctx, &schedulev2.UserSettings{UserId: userId})
+	rq.NoError(err)
+
+	grpcPlan, err := s.grpcClientV2.GetDayPlan(ctx, &schedulev2.GetDayPlanRequest{UserId: userId})
+	rq.NoError(err)
+	rq.False(grpcPlan.GetConsolidated())
+	rq.Len(grpcPlan.GetSlots(), 6)
+
+	grpcPlan, err = s.grpcClientV2.GetDayPlan(ctx, &schedulev2.GetDayPlanRequest{UserId: userId, Consolidate: true})
+	rq.NoError(err)
+	rq.True(grpcPlan.GetConsolidated())
+	rq.Len(grpcPlan.GetSlots(), 4)
+}
@@ -73,9 +73,9 @@ func (s *Suite) TestCreateScheduleHTTP() {
 				rq.Equal(tc.expectedData, data)
 
 			case http.StatusBadRequest:
-				rq.Equal(tc.expectedError, resp.JSON400)
+				rq.Equal(tc.expectedError.Error, resp.ApplicationproblemJSON400.Error)
 			case http.StatusInternalServerError:
-				rq.Equal(tc.expectedError, resp.JSON500)
+				rq.Equal(tc.expectedError.Error, resp.ApplicationproblemJSON500.Error)
 			default:
 				rq.Errorf(errors.New("unexpected status code"), "Code: %d\n body: %s", statusCode, string(resp.Body))
 			}
@@ -114,6 +114,14 @@ func (s *Suite) TestCreateScheduleGRPC() {
 				EndAt:  value.NewScheduleEndAt(util.Ptr(time.Date(2025, time.January, 11, 0, 0, 0, 0, time.UTC))),
 			},
 		},
+		{
+			name: "invalid",
+			request: schedulev1.CreateScheduleRequest{
+				UserId: userId,
+				Period: int64(time.Minute),
+			},
+			expectedCode: codes.InvalidArgument,
+		},
 	}
 
 	for _, tc := range testCases { //nolint:govet
@@ -0,0 +1,52 @@
+package tests
+
+import (
+	"context"
+	"net/http"
+	schedulev2 "schedule/pkg/grpc/v2"
+	"schedule/pkg/rest"
+	"time"
+)
+
+func (s *Suite) TestDayPlan() {
+	const (
+		userId = 1000000000000004
+	)
+
+	rq := s.Require()
+	ctx := context.Background()
+
+	ids := make([]int, 0, 2)
+	for _, req := range []rest.CreateUserScheduleRequest{
+		{Name: "Test day plan A", Period: "5h"},
+		{Name: "Test day plan B", Period: "12h"},
+	} {
+		created, err := s.httpClient.CreateUserScheduleWithResponse(ctx, userId, req)
+		rq.NoError(err)
+		rq.Equal(http.StatusCreated, created.StatusCode(), string(created.Body))
+		ids = append(ids, created.JSON201.Id)
+	}
+
+	a := rest.DayPlanDose{ScheduleId: ids[0], Name: "Test day plan A"}
+	b := rest.DayPlanDose{ScheduleId: ids[1], Name: "Test day plan B"}
+
+	resp, err := s.httpClient.GetUserDayPlanWithResponse(ctx, userId, &rest.GetUserDayPlanParams{})
+	rq.NoError(err)
+	rq.Equal(http.StatusOK, resp.StatusCode(), string(resp.Body))
+	rq.Equal("2025-01-01", resp.JSON200.Date.String())
+	rq.Equal([]rest.DayPlanSlot{
+		{Time: "08:00:00", Doses: []rest.DayPlanDose{a, b}},
+		{Time: "13:00:00", Doses: []rest.DayPlanDose{a}},
+		{Time: "18:00:00", Doses: []rest.DayPlanDose{a}},
+		{Time: "20:00:00", Doses: []rest.DayPlanDose{b}},
+	}, resp.JSON200.Slots)
+
+	plan, err := s.grpcClientV2.GetDayPlan(ctx, &schedulev2.GetDayPlanRequest{
+		UserId: userId,
+		Date:   "2025-01-02",
+	})
+	rq.NoError(err)
+	rq.Equal(time.Date(2025, time.January, 2, 0, 0, 0, 0, time.UTC), plan.GetDate().AsTime())
+	rq.Len(plan.GetSlots(), 4)
+	rq.Len(plan.GetSlots()[0].GetDoses(), 2)
+}
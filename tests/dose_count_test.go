@@ -0,0 +1,105 @@
+package tests
+
+import (
+	"context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"net/http"
+	"schedule/internal/util"
+	schedulev2 "schedule/pkg/grpc/v2"
+	"schedule/pkg/rest"
+	"time"
+)
+
+func (s *Suite) TestDoseCountHTTP() {
+	const (
+		userId = 1000000000000009
+	)
+
+	rq := s.Require()
+	ctx := context.Background()
+
+	created, err := s.httpClient.CreateUserScheduleWithResponse(ctx, userId, rest.CreateUserScheduleRequest{
+		Name:   "Test dose count",
+		Period: "5h",
+		Doses:  util.Ptr(5),
+	})
+	rq.NoError(err)
+	rq.Equal(http.StatusCreated, created.StatusCode(), string(created.Body))
+
+	scheduleId := created.JSON201.Id
+
+	schedule, err := s.httpClient.GetUserScheduleWithResponse(ctx, userId, scheduleId, &rest.GetUserScheduleParams{})
+	rq.NoError(err)
+	rq.Equal(http.StatusOK, schedule.StatusCode(), string(schedule.Body))
+	rq.Equal(util.Ptr(time.Date(2025, time.January, 2, 18, 0, 0, 0, time.UTC)), schedule.JSON200.FinalDoseAt)
+	rq.Equal(util.Ptr(5), schedule.JSON200.RemainingDoses)
+	rq.Equal(util.Ptr("2025-01-02T22:00:00Z"), schedule.JSON200.EndAt)
+
+	preview, err := s.httpClient.PreviewUserScheduleWithResponse(ctx, userId, &rest.PreviewUserScheduleParams{Days: util.Ptr(3)}, rest.CreateUserScheduleRequest{
+		Name:   "Test dose count",
+		Period: "5h",
+		Doses:  util.Ptr(4),
+	})
+	rq.NoError(err)
+	rq.Equal(http.StatusOK, preview.StatusCode(), string(preview.Body))
+	rq.Equal(util.Ptr(4), preview.JSON200.RemainingDoses)
+	rq.Len(preview.JSON200.Days, 2)
+	rq.Equal([]string{"08:00:00", "13:00:00"}, preview.JSON200.Days[1].Timetable)
+
+	invalid, err := s.httpClient.CreateUserScheduleWithResponse(ctx, userId, rest.CreateUserScheduleRequest{
+		Name:     "Test dose count",
+		Period:   "5h",
+		Duration: 5,
+		Doses:    util.Ptr(5),
+	})
+	rq.NoError(err)
+	rq.Equal(http.StatusBadRequest, invalid.StatusCode(), string(invalid.Body))
+
+	updated, err := s.httpClient.UpdateUserScheduleWithResponse(ctx, userId, scheduleId, &rest.UpdateUserScheduleParams{}, rest.UpdateScheduleRequest{
+		Period: util.Ptr("4h"),
+	})
+	rq.NoError(err)
+	rq.Equal(http.StatusOK, updated.StatusCode(), string(updated.Body))
+	rq.Equal(util.Ptr(time.Date(2025, time.January, 2, 16, 0, 0, 0, time.UTC)), updated.JSON200.FinalDoseAt)
+	rq.Equal(util.Ptr(5), updated.JSON200.RemainingDoses)
+
+	updated, err = s.httpClient.UpdateUserScheduleWithResponse(ctx, userId, scheduleId, &rest.UpdateUserScheduleParams{}, rest.UpdateScheduleRequest{
+		Doses: util.Ptr(0),
+	})
+	rq.NoError(err)
+	rq.Equal(http.StatusOK, updated.StatusCode(), string(updated.Body))
+	rq.Nil(updated.JSON200.FinalDoseAt)
+	rq.Nil(updated.JSON200.RemainingDoses)
+	rq.Nil(updated.JSON200.EndAt)
+}
+
+func (s *Suite) TestDoseCountGRPCV2() {
+	const (
+		userId = 1000000000000009
+	)
+
+	rq := s.Require()
+	ctx := context.Background()
+
+	created, err := s.grpcClientV2.CreateSchedule(ctx, &schedulev2.CreateScheduleRequest{
+		UserId: userId,
+		Name:   "Test dose count",
+		Period: durationpb.New(time.Hour * 4),
+		Doses:  2,
+	})
+	rq.NoError(err)
+	rq.Equal(time.Date(2025, time.January, 1, 20, 0, 0, 0, time.UTC), created.GetFinalDoseTime().AsTime())
+	rq.Equal(util.Ptr(uint32(2)), created.RemainingDoses)
+	rq.Len(created.GetTimetable(), 4)
+
+	_, err = s.grpcClientV2.CreateSchedule(ctx, &schedulev2.CreateScheduleRequest{
+		UserId:       userId,
+		Name:         "Test dose count",
+		Period:       durationpb.New(time.Hour * 4),
+		DurationDays: util.Ptr(uint32(5)),
+		Doses:        2,
+	})
+	rq.Equal(codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,113 @@
+package tests
+
+import (
+	"context"
+	"net/http"
+	"schedule/internal/util"
+	schedulev2 "schedule/pkg/grpc/v2"
+	"schedule/pkg/rest"
+	"time"
+)
+
+func (s *Suite) TestExplainHTTP() {
+	const (
+		userId = 1000000000000003
+	)
+
+	rq := s.Require()
+	ctx := context.Background()
+
+	created, err := s.httpClient.CreateUserScheduleWithResponse(ctx, userId, rest.CreateUserScheduleRequest{
+		Name:   "Test explain name",
+		Period: "5h",
+	})
+	rq.NoError(err)
+	rq.Equal(http.StatusCreated, created.StatusCode(), string(created.Body))
+
+	scheduleId := created.JSON201.Id
+
+	slot := func(hour int, reason rest.SlotDecisionReason) rest.SlotDecision {
+		decision := rest.SlotDecision{
+			ScheduleId: scheduleId,
+			Time:       time.Date(2025, time.January, 1, hour, 0, 0, 0, time.UTC).Format(time.RFC3339),
+			Kept:       reason == "",
+		}
+		if reason != "" {
+			decision.Reason = util.Ptr(reason)
+		}
+		return decision
+	}
+
+	schedule, err := s.httpClient.GetUserScheduleWithResponse(ctx, userId, scheduleId, &rest.GetUserScheduleParams{
+		Explain: util.Ptr(true),
+	})
+	rq.NoError(err)
+	rq.Equal(http.StatusOK, schedule.StatusCode(), string(schedule.Body))
+	rq.Equal([]string{"08:00:00", "13:00:00", "18:00:00"}, schedule.JSON200.Timetable)
+	rq.NotNil(schedule.JSON200.Explanation)
+	rq.Equal([]rest.SlotDecision{
+		slot(8, ""),
+		slot(13, ""),
+		slot(18, ""),
+		slot(23, rest.SlotDecisionReasonNight),
+	}, *schedule.JSON200.Explanation)
+
+	plain, err := s.httpClient.GetUserScheduleWithResponse(ctx, userId, scheduleId, &rest.GetUserScheduleParams{})
+	rq.NoError(err)
+	rq.Equal(http.StatusOK, plain.StatusCode(), string(plain.Body))
+	rq.Nil(plain.JSON200.Explanation)
+
+	nextTakings, err := s.httpClient.GetUserNextTakingsWithResponse(ctx, userId, &rest.GetUserNextTakingsParams{
+		Explain: util.Ptr(true),
+	})
+	rq.NoError(err)
+	rq.Equal(http.StatusOK, nextTakings.StatusCode(), string(nextTakings.Body))
+	rq.Len(*nextTakings.JSON200, 1)
+	rq.NotNil((*nextTakings.JSON200)[0].Explanation)
+	rq.Equal([]rest.SlotDecision{
+		slot(8, rest.SlotDecisionReasonPassed),
+		slot(13, ""),
+		slot(18, rest.SlotDecisionReasonOutsidePeriod),
+	}, *(*nextTakings.JSON200)[0].Explanation)
+}
+
+func (s *Suite) TestExplainGRPCV2() {
+	const (
+		userId = 1000000000000003
+	)
+
+	rq := s.Require()
+	ctx := context.Background()
+
+	created, err := s.httpClient.CreateUserScheduleWithResponse(ctx, userId, rest.CreateUserScheduleRequest{
+		Name:   "Test explain name",
+		Period: "5h",
+	})
+	rq.NoError(err)
+	rq.Equal(http.StatusCreated, created.StatusCode(), string(created.Body))
+
+	resp, err := s.grpcClientV2.GetSchedule(ctx, &schedulev2.GetScheduleRequest{
+		UserId:     userId,
+		ScheduleId: int32(created.JSON201.Id),
+		Explain:    true,
+	})
+	rq.NoError(err)
+
+	reasons := make([]schedulev2.SlotRejectReason, len(resp.GetExplanation()))
+	for i, decision := range resp.GetExplanation() {
+		reasons[i] = decision.GetReason()
+	}
+	rq.Equal([]schedulev2.SlotRejectReason{
+		schedulev2.SlotRejectReason_SLOT_REJECT_REASON_UNSPECIFIED,
+		schedulev2.SlotRejectReason_SLOT_REJECT_REASON_UNSPECIFIED,
+		schedulev2.SlotRejectReason_SLOT_REJECT_REASON_UNSPECIFIED,
+		schedulev2.SlotRejectReason_SLOT_REJECT_REASON_NIGHT,
+	}, reasons)
+
+	plain, err := s.grpcClientV2.GetSchedule(ctx, &schedulev2.GetScheduleRequest{
+		UserId:     userId,
+		ScheduleId: int32(created.JSON201.Id),
+	})
+	rq.NoError(err)
+	rq.Empty(plain.GetExplanation())
+}
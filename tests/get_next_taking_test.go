@@ -81,9 +81,9 @@ func (s *Suite) TestGetNextTakingHTTP() {
 			case http.StatusOK:
 				rq.EqualValues(tc.expectedResponse, *resp.JSON200)
 			case http.StatusBadRequest:
-				rq.Equal(tc.expectedError, resp.JSON400)
+				rq.Equal(tc.expectedError.Error, resp.ApplicationproblemJSON400.Error)
 			case http.StatusInternalServerError:
-				rq.Equal(tc.expectedError, resp.JSON500)
+				rq.Equal(tc.expectedError.Error, resp.ApplicationproblemJSON500.Error)
 			default:
 				rq.Errorf(errors.New("unexpected status code"), "Code: %d\n body: %s", statusCode, string(resp.Body))
 			}
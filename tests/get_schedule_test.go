@@ -88,11 +88,11 @@ func (s *Suite) TestGetScheduleHTTP() {
 			case http.StatusOK:
 				rq.EqualValues(&tc.expectedResponse, resp.JSON200)
 			case http.StatusBadRequest:
-				rq.Equal(&tc.expectedError, resp.JSON400)
+				rq.Equal(tc.expectedError.Error, resp.ApplicationproblemJSON400.Error)
 			case http.StatusNotFound:
-				rq.Equal(&tc.expectedError, resp.JSON404)
+				rq.Equal(tc.expectedError.Error, resp.ApplicationproblemJSON404.Error)
 			case http.StatusInternalServerError:
-				rq.Equal(&tc.expectedError, resp.JSON500)
+				rq.Equal(tc.expectedError.Error, resp.ApplicationproblemJSON500.Error)
 			default:
 				rq.Errorf(errors.New("unexpected status code"), "Code: %d\n body: %s", statusCode, string(resp.Body))
 			}
@@ -0,0 +1,41 @@
+package tests
+
+import (
+	"context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"net/http"
+	schedulev1 "schedule/pkg/grpc"
+)
+
+func (s *Suite) TestHealthHTTP() {
+	rq := s.Require()
+
+	for _, path := range []string{"/healthz", "/readyz"} {
+		s.Run(path, func() {
+			resp, err := http.Get("http://" + s.app.HttpAddr().String() + path)
+			rq.NoError(err)
+			defer resp.Body.Close()
+
+			rq.Equal(http.StatusOK, resp.StatusCode)
+		})
+	}
+}
+
+func (s *Suite) TestHealthGRPC() {
+	rq := s.Require()
+	ctx := context.Background()
+
+	conn, err := grpc.NewClient(s.app.GrpcAddr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	rq.NoError(err)
+	defer conn.Close()
+
+	client := healthpb.NewHealthClient(conn)
+
+	for _, service := range []string{"", schedulev1.Schedule_ServiceDesc.ServiceName} {
+		resp, err := client.Check(ctx, &healthpb.HealthCheckRequest{Service: service})
+		rq.NoError(err)
+		rq.Equal(healthpb.HealthCheckResponse_SERVING, resp.GetStatus())
+	}
+}
@@ -0,0 +1,162 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"schedule/internal/util"
+	"schedule/pkg/errcodes"
+	"schedule/pkg/rest"
+	"strings"
+)
+
+func (s *Suite) TestImportSchedulesHTTP() {
+	rq := s.Require()
+	ctx := context.Background()
+
+	const validCSV = `user_id,name,duration,period
+1000000000000000,Test import name1,10,1h
+1000000000000000,Test import name2,,2h
+`
+	const invalidCSV = `user_id,name,duration,period
+1000000000000000,Test import name1,10,1h
+1000000000000000,,10,1h
+1000000000000000,Test import name3,10,10m
+`
+
+	testCases := []struct {
+		name             string
+		bootstrap        func()
+		mode             rest.PostSchedulesImportParamsMode
+		contentType      string
+		body             string
+		expectedResponse rest.ImportSchedulesResponse
+		expectedCount    int
+		expectedStatus   int
+		expectedError    rest.ErrorResponse
+	}{
+		{
+			name:        "success csv",
+			mode:        rest.Atomic,
+			contentType: "text/csv",
+			body:        validCSV,
+			expectedResponse: rest.ImportSchedulesResponse{
+				Created: 2,
+				Rows: []rest.ImportScheduleRow{
+					{Line: 2},
+					{Line: 3},
+				},
+			},
+			expectedCount:  2,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:        "success json lines",
+			mode:        rest.Atomic,
+			contentType: "application/x-ndjson",
+			body: `{"user_id": 1000000000000000, "name": "Test import name1", "duration": 10, "period": "1h"}
+{"user_id": 1000000000000000, "name": "Test import name2", "duration": 0, "period": "2h"}`,
+			expectedResponse: rest.ImportSchedulesResponse{
+				Created: 2,
+				Rows: []rest.ImportScheduleRow{
+					{Line: 1},
+					{Line: 2},
+				},
+			},
+			expectedCount:  2,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:        "atomic with invalid rows",
+			mode:        rest.Atomic,
+			contentType: "text/csv",
+			body:        invalidCSV,
+			expectedResponse: rest.ImportSchedulesResponse{
+				Failed: 2,
+				Rows: []rest.ImportScheduleRow{
+					{Line: 2},
+					{Line: 3, Error: util.Ptr("name is required")},
+					{Line: 4, Error: util.Ptr("period is too short")},
+				},
+			},
+			expectedCount:  0,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:        "per row with invalid rows",
+			mode:        rest.PerRow,
+			contentType: "text/csv",
+			body:        invalidCSV,
+			expectedResponse: rest.ImportSchedulesResponse{
+				Created: 1,
+				Failed:  2,
+				Rows: []rest.ImportScheduleRow{
+					{Line: 2},
+					{Line: 3, Error: util.Ptr("name is required")},
+					{Line: 4, Error: util.Ptr("period is too short")},
+				},
+			},
+			expectedCount:  1,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "too many rows",
+			mode:           rest.Atomic,
+			contentType:    "text/csv",
+			body:           "user_id,name,duration,period\n" + strings.Repeat("1000000000000000,Test import name,10,1h\n", 10001),
+			expectedStatus: http.StatusBadRequest,
+			expectedError: rest.ErrorResponse{
+				Error: errcodes.Validation.String(),
+			},
+		},
+		{
+			name:           "unsupported content type",
+			mode:           rest.Atomic,
+			contentType:    "text/plain",
+			body:           validCSV,
+			expectedStatus: http.StatusBadRequest,
+			expectedError: rest.ErrorResponse{
+				Error: errcodes.UnsupportedMediaType.String(),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			if tc.bootstrap != nil {
+				tc.bootstrap()
+			}
+
+			params := &rest.PostSchedulesImportParams{Mode: &tc.mode}
+
+			resp, err := s.httpClient.PostSchedulesImportWithBodyWithResponse(ctx, params, tc.contentType, strings.NewReader(tc.body))
+			rq.NoError(err)
+
+			statusCode := resp.StatusCode()
+
+			rq.Equal(tc.expectedStatus, statusCode)
+
+			switch statusCode {
+			case http.StatusOK:
+				for i := range resp.JSON200.Rows {
+					resp.JSON200.Rows[i].Id = nil // ids depend on auto increment
+				}
+				rq.Equal(tc.expectedResponse, *resp.JSON200)
+
+				var count int
+				err = s.db.GetContext(ctx, &count, "SELECT COUNT(*) FROM schedule")
+				rq.NoError(err)
+
+				rq.Equal(tc.expectedCount, count)
+			case http.StatusBadRequest:
+				rq.Equal(tc.expectedError.Error, resp.ApplicationproblemJSON400.Error)
+			case http.StatusInternalServerError:
+				rq.Equal(tc.expectedError.Error, resp.ApplicationproblemJSON500.Error)
+			default:
+				rq.Errorf(errors.New("unexpected status code"), "Code: %d\n body: %s", statusCode, string(resp.Body))
+			}
+		})
+	}
+}
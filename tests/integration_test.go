@@ -1,40 +1,39 @@
 package tests
 
 import (
-	"bou.ke/monkey"
+	"context"
 	"github.com/jmoiron/sqlx"
 	"github.com/stretchr/testify/suite"
-	"golang.org/x/sys/windows"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
-	"os"
-	"runtime"
 	"schedule/internal/app"
 	"schedule/internal/config"
+	"schedule/internal/domain/usecase/schedule"
 	"schedule/internal/infrastructure/persistence/mysql"
 	"schedule/pkg/dbtest"
 	schedulev1 "schedule/pkg/grpc"
+	schedulev2 "schedule/pkg/grpc/v2"
 	"schedule/pkg/rest"
-	"sync"
 	"testing"
 	"time"
 )
 
+var testNow = time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC)
+
 func init() {
-	now := time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC)
-	monkey.Patch(time.Now, func() time.Time { return now })
 	time.Local = nil
 }
 
 type Suite struct {
 	suite.Suite
 
-	wg  sync.WaitGroup
+	app *app.App
 	cfg *config.Config
 	db  *sqlx.DB
 
-	httpClient rest.ClientWithResponsesInterface
-	grpcClient schedulev1.ScheduleClient
+	httpClient   rest.ClientWithResponsesInterface
+	grpcClient   schedulev1.ScheduleClient
+	grpcClientV2 schedulev2.ScheduleServiceClient
 }
 
 func TestIntegration(t *testing.T) {
@@ -53,26 +52,20 @@ func (s *Suite) SetupSuite() {
 	s.cfg, err = config.ReadConfig("../config/config.yaml", "../.env")
 	rq.NoError(err)
 
-	s.wg.Add(1)
-	go func() {
-		defer s.wg.Done()
-
-		app.Run(s.cfg)
-	}()
-
-	// Wait for the application to start.
-	time.Sleep(time.Second)
+	s.app = app.New(s.cfg, app.WithClock(schedule.ClockFunc(func() time.Time { return testNow })))
+	rq.NoError(s.app.Start(context.Background()))
 
 	s.db, err = mysql.Connect(s.cfg.MySQl)
 	rq.NoError(err)
 
-	s.httpClient, err = rest.NewClientWithResponses("http://" + s.cfg.HttpServer.Addr)
+	s.httpClient, err = rest.NewClientWithResponses("http://" + s.app.HttpAddr().String())
 	rq.NoError(err)
 
-	grpcConn, err := grpc.NewClient(s.cfg.GrpcServer.Addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	grpcConn, err := grpc.NewClient(s.app.GrpcAddr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
 	rq.NoError(err)
 
 	s.grpcClient = schedulev1.NewScheduleClient(grpcConn)
+	s.grpcClientV2 = schedulev2.NewScheduleServiceClient(grpcConn)
 }
 
 func (s *Suite) SetupTest() {
@@ -85,17 +78,10 @@ func (s *Suite) SetupTest() {
 func (s *Suite) TearDownSuite() {
 	rq := s.Require()
 
-	if runtime.GOOS == "windows" {
-		// Работает только при запуске тестов из командной строки.
-		// Если запускать в IDE (goland), то app не получит сигнал и завершатся сами тесты, а IDE выведет "No tests were run"
-		rq.NoError(windows.GenerateConsoleCtrlEvent(windows.CTRL_C_EVENT, 0))
-	} else {
-		p, err := os.FindProcess(os.Getpid())
-		rq.NoError(err)
-		rq.NoError(p.Signal(os.Interrupt))
-	}
+	ctx, cancel := context.WithTimeout(context.Background(), s.cfg.HttpServer.ShutdownTimeout)
+	defer cancel()
 
-	s.wg.Wait()
+	rq.NoError(s.app.Stop(ctx))
 
 	rq.NoError(s.db.Close())
 
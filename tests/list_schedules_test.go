@@ -0,0 +1,279 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/http"
+	"schedule/internal/util"
+	"schedule/pkg/dbtest"
+	"schedule/pkg/errcodes"
+	schedulev1 "schedule/pkg/grpc"
+	"schedule/pkg/rest"
+	"time"
+)
+
+func (s *Suite) TestListSchedulesHTTP() {
+	const (
+		userId = 1000000000000000
+	)
+
+	rq := s.Require()
+	ctx := context.Background()
+
+	err := dbtest.MigrateFromFile(s.db, "testdata/list_schedules.sql")
+	rq.NoError(err)
+
+	var (
+		aspirin = rest.ScheduleItem{
+			Id:     1,
+			Name:   "Test list_schedules Aspirin",
+			EndAt:  util.Ptr("2025-01-01T22:00:00Z"),
+			Period: time.Hour.String(),
+		}
+		paracetamol = rest.ScheduleItem{
+			Id:     2,
+			Name:   "Test list_schedules Paracetamol",
+			EndAt:  util.Ptr("2025-01-10T22:00:00Z"),
+			Period: (time.Hour * 2).String(),
+		}
+		ibuprofen = rest.ScheduleItem{
+			Id:     3,
+			Name:   "Test list_schedules Ibuprofen",
+			Period: (time.Hour * 5).String(),
+		}
+		expired = rest.ScheduleItem{
+			Id:     4,
+			Name:   "Test list_schedules expired",
+			EndAt:  util.Ptr("2024-12-31T22:00:00Z"),
+			Period: time.Hour.String(),
+		}
+	)
+
+	testCases := []struct {
+		name             string
+		bootstrap        func()
+		request          rest.GetSchedulesListParams
+		expectedResponse []rest.ScheduleItem
+		expectedStatus   int
+		expectedError    rest.ErrorResponse
+	}{
+		{
+			name: "all",
+			request: rest.GetSchedulesListParams{
+				UserId: userId,
+			},
+			expectedResponse: []rest.ScheduleItem{aspirin, paracetamol, ibuprofen, expired},
+			expectedStatus:   http.StatusOK,
+		},
+		{
+			name: "active sorted by name",
+			request: rest.GetSchedulesListParams{
+				UserId: userId,
+				Status: util.Ptr(rest.GetSchedulesListParamsStatusActive),
+				Sort:   util.Ptr(rest.GetSchedulesListParamsSortName),
+			},
+			expectedResponse: []rest.ScheduleItem{aspirin, ibuprofen, paracetamol},
+			expectedStatus:   http.StatusOK,
+		},
+		{
+			name: "expired",
+			request: rest.GetSchedulesListParams{
+				UserId: userId,
+				Status: util.Ptr(rest.GetSchedulesListParamsStatusExpired),
+			},
+			expectedResponse: []rest.ScheduleItem{expired},
+			expectedStatus:   http.StatusOK,
+		},
+		{
+			name: "name substring",
+			request: rest.GetSchedulesListParams{
+				UserId: userId,
+				Name:   util.Ptr("PROFEN"),
+			},
+			expectedResponse: []rest.ScheduleItem{ibuprofen},
+			expectedStatus:   http.StatusOK,
+		},
+		{
+			name: "end date range desc",
+			request: rest.GetSchedulesListParams{
+				UserId:    userId,
+				EndAtFrom: &openapi_types.Date{Time: time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)},
+				EndAtTo:   &openapi_types.Date{Time: time.Date(2025, time.January, 31, 0, 0, 0, 0, time.UTC)},
+				Sort:      util.Ptr(rest.GetSchedulesListParamsSortEndAt),
+				Order:     util.Ptr(rest.GetSchedulesListParamsOrderDesc),
+			},
+			expectedResponse: []rest.ScheduleItem{paracetamol, aspirin},
+			expectedStatus:   http.StatusOK,
+		},
+		{
+			name: "invalid status",
+			request: rest.GetSchedulesListParams{
+				UserId: userId,
+				Status: util.Ptr(rest.GetSchedulesListParamsStatus("unknown")),
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError: rest.ErrorResponse{
+				Error: errcodes.Validation.String(),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			if tc.bootstrap != nil {
+				tc.bootstrap()
+			}
+
+			resp, err := s.httpClient.GetSchedulesListWithResponse(ctx, &tc.request)
+			rq.NoError(err)
+
+			statusCode := resp.StatusCode()
+
+			rq.Equal(tc.expectedStatus, statusCode)
+
+			switch statusCode {
+			case http.StatusOK:
+				rq.Equal(tc.expectedResponse, resp.JSON200.Items)
+				rq.Nil(resp.JSON200.NextCursor)
+			case http.StatusBadRequest:
+				rq.Equal(tc.expectedError.Error, resp.ApplicationproblemJSON400.Error)
+			case http.StatusInternalServerError:
+				rq.Equal(tc.expectedError.Error, resp.ApplicationproblemJSON500.Error)
+			default:
+				rq.Errorf(errors.New("unexpected status code"), "Code: %d\n body: %s", statusCode, string(resp.Body))
+			}
+		})
+	}
+}
+
+func (s *Suite) TestListSchedulesPaginationHTTP() {
+	const (
+		userId = 1000000000000000
+	)
+
+	rq := s.Require()
+	ctx := context.Background()
+
+	err := dbtest.MigrateFromFile(s.db, "testdata/list_schedules.sql")
+	rq.NoError(err)
+
+	var (
+		ids    []int
+		cursor *string
+	)
+
+	for range 3 {
+		resp, err := s.httpClient.GetSchedulesListWithResponse(ctx, &rest.GetSchedulesListParams{
+			UserId: userId,
+			Sort:   util.Ptr(rest.GetSchedulesListParamsSortName),
+			Limit:  util.Ptr(2),
+			Cursor: cursor,
+		})
+		rq.NoError(err)
+		rq.Equal(http.StatusOK, resp.StatusCode())
+
+		for _, item := range resp.JSON200.Items {
+			ids = append(ids, item.Id)
+		}
+
+		cursor = resp.JSON200.NextCursor
+		if cursor == nil {
+			break
+		}
+	}
+
+	rq.Equal([]int{1, 4, 3, 2}, ids) // case insensitive collation
+	rq.Nil(cursor)
+
+	first, err := s.httpClient.GetSchedulesListWithResponse(ctx, &rest.GetSchedulesListParams{
+		UserId: userId,
+		Sort:   util.Ptr(rest.GetSchedulesListParamsSortName),
+		Limit:  util.Ptr(2),
+	})
+	rq.NoError(err)
+	rq.Equal(http.StatusOK, first.StatusCode())
+	rq.NotNil(first.JSON200.NextCursor)
+
+	mismatch, err := s.httpClient.GetSchedulesListWithResponse(ctx, &rest.GetSchedulesListParams{
+		UserId: userId,
+		Sort:   util.Ptr(rest.GetSchedulesListParamsSortEndAt),
+		Limit:  util.Ptr(2),
+		Cursor: first.JSON200.NextCursor,
+	})
+	rq.NoError(err)
+	rq.Equal(http.StatusBadRequest, mismatch.StatusCode(), string(mismatch.Body))
+}
+
+func (s *Suite) TestListSchedulesGRPC() {
+	const (
+		userId = 1000000000000000
+	)
+
+	rq := s.Require()
+	ctx := context.Background()
+
+	err := dbtest.MigrateFromFile(s.db, "testdata/list_schedules.sql")
+	rq.NoError(err)
+
+	testCases := []struct {
+		name         string
+		bootstrap    func()
+		request      schedulev1.ListSchedulesRequest
+		expectedIds  []int32
+		expectedCode codes.Code
+	}{
+		{
+			name: "active",
+			request: schedulev1.ListSchedulesRequest{
+				UserId: userId,
+				Status: "active",
+			},
+			expectedIds: []int32{1, 2, 3},
+		},
+		{
+			name: "sorted by end date",
+			request: schedulev1.ListSchedulesRequest{
+				UserId: userId,
+				Sort:   "end_at",
+			},
+			expectedIds: []int32{4, 1, 2, 3},
+		},
+		{
+			name: "invalid sort",
+			request: schedulev1.ListSchedulesRequest{
+				UserId: userId,
+				Sort:   "period",
+			},
+			expectedCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases { //nolint:govet
+		s.Run(tc.name, func() {
+			if tc.bootstrap != nil {
+				tc.bootstrap()
+			}
+
+			resp, err := s.grpcClient.ListSchedules(ctx, &tc.request)
+
+			statusCode := status.Code(err)
+			rq.Equal(tc.expectedCode, statusCode)
+
+			if statusCode != codes.OK {
+				return
+			}
+
+			rq.NoError(err)
+
+			ids := make([]int32, len(resp.GetItems()))
+			for i, item := range resp.GetItems() {
+				ids[i] = item.GetId()
+			}
+
+			rq.Equal(tc.expectedIds, ids)
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package tests
+
+import (
+	"context"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"net/http"
+	"schedule/internal/util"
+	schedulev2 "schedule/pkg/grpc/v2"
+	"schedule/pkg/rest"
+	"time"
+)
+
+func (s *Suite) TestPreviewUserScheduleHTTP() {
+	const (
+		userId = 1000000000000002
+	)
+
+	rq := s.Require()
+	ctx := context.Background()
+
+	countSchedules := func() int {
+		var count int
+		rq.NoError(s.db.GetContext(ctx, &count, "SELECT COUNT(*) FROM schedule WHERE user_id = ?", userId))
+		return count
+	}
+	before := countSchedules()
+
+	resp, err := s.httpClient.PreviewUserScheduleWithResponse(ctx, userId, &rest.PreviewUserScheduleParams{Days: util.Ptr(3)}, rest.CreateUserScheduleRequest{
+		Name:     "Test name",
+		Duration: 1,
+		Period:   "5h",
+	})
+	rq.NoError(err)
+	rq.Equal(http.StatusOK, resp.StatusCode(), string(resp.Body))
+
+	rq.Equal(&rest.SchedulePreviewResponse{
+		Name:   "Test name",
+		EndAt:  util.Ptr(time.Date(2025, time.January, 2, s.cfg.Schedule.EndDayHour, 0, 0, 0, time.UTC).Format(time.RFC3339)),
+		Period: (time.Hour * 5).String(),
+		Days: []rest.SchedulePreviewDay{
+			{Date: openapi_types.Date{Time: time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)}, Timetable: []string{"08:00:00", "13:00:00", "18:00:00"}},
+			{Date: openapi_types.Date{Time: time.Date(2025, time.January, 2, 0, 0, 0, 0, time.UTC)}, Timetable: []string{"08:00:00", "13:00:00", "18:00:00"}},
+		},
+		NextTakings: []string{time.Date(2025, time.January, 1, 13, 0, 0, 0, time.UTC).Format(time.RFC3339)},
+	}, resp.JSON200)
+
+	rq.Equal(before, countSchedules())
+
+	invalid, err := s.httpClient.PreviewUserScheduleWithResponse(ctx, userId, &rest.PreviewUserScheduleParams{}, rest.CreateUserScheduleRequest{
+		Name:   "Test name",
+		Period: "1m",
+	})
+	rq.NoError(err)
+	rq.Equal(http.StatusBadRequest, invalid.StatusCode())
+}
+
+func (s *Suite) TestPreviewScheduleGRPCV2() {
+	const (
+		userId = 1000000000000002
+	)
+
+	rq := s.Require()
+	ctx := context.Background()
+
+	resp, err := s.grpcClientV2.PreviewSchedule(ctx, &schedulev2.PreviewScheduleRequest{
+		Schedule: &schedulev2.CreateScheduleRequest{
+			UserId: userId,
+			Name:   "Test name",
+			Period: durationpb.New(time.Hour * 5),
+		},
+	})
+	rq.NoError(err)
+
+	expected := &schedulev2.PreviewScheduleResponse{
+		Schedule: &schedulev2.Schedule{
+			Name:   "Test name",
+			Period: durationpb.New(time.Hour * 5),
+		},
+		Days: []*schedulev2.PreviewDay{
+			{
+				Date: timestamppb.New(time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)),
+				Timetable: []*timestamppb.Timestamp{
+					timestamppb.New(time.Date(2025, time.January, 1, 8, 0, 0, 0, time.UTC)),
+					timestamppb.New(time.Date(2025, time.January, 1, 13, 0, 0, 0, time.UTC)),
+					timestamppb.New(time.Date(2025, time.January, 1, 18, 0, 0, 0, time.UTC)),
+				},
+			},
+		},
+		NextTakings: []*timestamppb.Timestamp{
+			timestamppb.New(time.Date(2025, time.January, 1, 13, 0, 0, 0, time.UTC)),
+		},
+	}
+	rq.True(proto.Equal(expected, resp), "expected: %v\n actual: %v", expected, resp)
+
+	_, err = s.grpcClientV2.PreviewSchedule(ctx, &schedulev2.PreviewScheduleRequest{
+		Schedule: &schedulev2.CreateScheduleRequest{
+			UserId: userId,
+			Period: durationpb.New(time.Hour * 5),
+		},
+	})
+	rq.Equal(codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,87 @@
+package tests
+
+import (
+	"context"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"net/http"
+	"schedule/internal/util"
+	schedulev2 "schedule/pkg/grpc/v2"
+	"schedule/pkg/rest"
+	"time"
+)
+
+func (s *Suite) TestRoundTheClockHTTP() {
+	const (
+		userId = 1000000000000006
+	)
+
+	rq := s.Require()
+	ctx := context.Background()
+
+	created, err := s.httpClient.CreateUserScheduleWithResponse(ctx, userId, rest.CreateUserScheduleRequest{
+		Name:          "Test round the clock",
+		Period:        "5h",
+		RoundTheClock: util.Ptr(true),
+	})
+	rq.NoError(err)
+	rq.Equal(http.StatusCreated, created.StatusCode(), string(created.Body))
+
+	scheduleId := created.JSON201.Id
+
+	schedule, err := s.httpClient.GetUserScheduleWithResponse(ctx, userId, scheduleId, &rest.GetUserScheduleParams{})
+	rq.NoError(err)
+	rq.Equal(http.StatusOK, schedule.StatusCode(), string(schedule.Body))
+	rq.True(schedule.JSON200.RoundTheClock)
+	rq.Equal([]string{"08:00:00", "13:00:00", "18:00:00", "23:00:00"}, schedule.JSON200.Timetable)
+	rq.Equal(util.Ptr([]string{"23:00:00"}), schedule.JSON200.NightSlots)
+
+	plan, err := s.httpClient.GetUserDayPlanWithResponse(ctx, userId, &rest.GetUserDayPlanParams{
+		Date: util.Ptr(openapi_types.Date{Time: time.Date(2025, time.January, 2, 0, 0, 0, 0, time.UTC)}),
+	})
+	rq.NoError(err)
+	rq.Equal(http.StatusOK, plan.StatusCode(), string(plan.Body))
+	dose := []rest.DayPlanDose{{ScheduleId: scheduleId, Name: "Test round the clock"}}
+	rq.Equal([]rest.DayPlanSlot{
+		{Time: "04:00:00", Night: true, Doses: dose},
+		{Time: "09:00:00", Doses: dose},
+		{Time: "14:00:00", Doses: dose},
+		{Time: "19:00:00", Doses: dose},
+	}, plan.JSON200.Slots)
+
+	updated, err := s.httpClient.UpdateUserScheduleWithResponse(ctx, userId, scheduleId, &rest.UpdateUserScheduleParams{}, rest.UpdateScheduleRequest{
+		RoundTheClock: util.Ptr(false),
+	})
+	rq.NoError(err)
+	rq.Equal(http.StatusOK, updated.StatusCode(), string(updated.Body))
+	rq.False(updated.JSON200.RoundTheClock)
+	rq.Equal([]string{"08:00:00", "13:00:00", "18:00:00"}, updated.JSON200.Timetable)
+	rq.Nil(updated.JSON200.NightSlots)
+}
+
+func (s *Suite) TestRoundTheClockGRPCV2() {
+	const (
+		userId = 1000000000000006
+	)
+
+	rq := s.Require()
+	ctx := context.Background()
+
+	created, err := s.grpcClientV2.CreateSchedule(ctx, &schedulev2.CreateScheduleRequest{
+		UserId:        userId,
+		Name:          "Test round the clock",
+		Period:        durationpb.New(time.Hour * 5),
+		RoundTheClock: true,
+	})
+	rq.NoError(err)
+	rq.True(created.GetRoundTheClock())
+
+	schedule, err := s.grpcClientV2.GetSchedule(ctx, &schedulev2.GetScheduleRequest{
+		UserId:     userId,
+		ScheduleId: created.GetId(),
+	})
+	rq.NoError(err)
+	rq.Len(schedule.GetTimetable(), 4)
+	rq.Len(schedule.GetNightSlots(), 1)
+	rq.Equal(time.Date(2025, time.January, 1, 23, 0, 0, 0, time.UTC), schedule.GetNightSlots()[0].AsTime())
+}
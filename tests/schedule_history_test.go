@@ -0,0 +1,79 @@
+package tests
+
+import (
+	"context"
+	"net/http"
+	"schedule/internal/util"
+	"schedule/pkg/rest"
+	"time"
+)
+
+func (s *Suite) TestScheduleHistoryHTTP() {
+	const (
+		userId  = 1000000000000000
+		actor   = "doctor:42"
+		traceId = "history-trace-id"
+	)
+
+	rq := s.Require()
+	ctx := context.Background()
+
+	withHeaders := func(_ context.Context, req *http.Request) error {
+		req.Header.Set("X-Actor", actor)
+		req.Header.Set("X-Trace-Id", traceId)
+		return nil
+	}
+
+	created, err := s.httpClient.CreateUserScheduleWithResponse(ctx, userId, rest.CreateUserScheduleRequest{
+		Name:   "Test name",
+		Period: "12h",
+	}, withHeaders)
+	rq.NoError(err)
+	rq.Equal(http.StatusCreated, created.StatusCode(), string(created.Body))
+
+	scheduleId := created.JSON201.Id
+
+	updated, err := s.httpClient.UpdateUserScheduleWithResponse(ctx, userId, scheduleId, &rest.UpdateUserScheduleParams{}, rest.UpdateScheduleRequest{
+		Name: util.Ptr("Updated name"),
+	}, withHeaders)
+	rq.NoError(err)
+	rq.Equal(http.StatusOK, updated.StatusCode(), string(updated.Body))
+
+	deleted, err := s.httpClient.DeleteUserScheduleWithResponse(ctx, userId, scheduleId, withHeaders)
+	rq.NoError(err)
+	rq.Equal(http.StatusNoContent, deleted.StatusCode())
+
+	resp, err := s.httpClient.GetUserScheduleHistoryWithResponse(ctx, userId, scheduleId)
+	rq.NoError(err)
+	rq.Equal(http.StatusOK, resp.StatusCode())
+
+	records := *resp.JSON200
+	rq.Len(records, 3)
+
+	created1 := &rest.ScheduleSnapshot{Name: "Test name", Period: (time.Hour * 12).String()}
+	updated1 := &rest.ScheduleSnapshot{Name: "Updated name", Period: (time.Hour * 12).String()}
+
+	expected := []struct {
+		action rest.AuditRecordAction
+		before *rest.ScheduleSnapshot
+		after  *rest.ScheduleSnapshot
+	}{
+		{action: "create", after: created1},
+		{action: "update", before: created1, after: updated1},
+		{action: "delete", before: updated1},
+	}
+
+	for i, record := range records {
+		rq.Equal(expected[i].action, record.Action)
+		rq.Equal(expected[i].before, record.Before)
+		rq.Equal(expected[i].after, record.After)
+		rq.Equal("anonymous", record.Actor, "X-Actor is not authentication")
+		rq.Equal(util.Ptr(actor), record.ClaimedActor)
+		rq.Equal(traceId, record.TraceId)
+	}
+
+	other, err := s.httpClient.GetUserScheduleHistoryWithResponse(ctx, userId+1, scheduleId)
+	rq.NoError(err)
+	rq.Equal(http.StatusOK, other.StatusCode())
+	rq.Empty(*other.JSON200)
+}
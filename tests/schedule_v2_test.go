@@ -0,0 +1,170 @@
+package tests
+
+import (
+	"context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"schedule/pkg/dbtest"
+	schedulev2 "schedule/pkg/grpc/v2"
+	"time"
+)
+
+func (s *Suite) TestCreateScheduleGRPCV2() {
+	const (
+		userId = 1000000000000000
+	)
+
+	rq := s.Require()
+	ctx := context.Background()
+
+	testCases := []struct {
+		name             string
+		bootstrap        func()
+		request          *schedulev2.CreateScheduleRequest
+		expectedResponse *schedulev2.Schedule
+		expectedCode     codes.Code
+	}{
+		{
+			name: "success",
+			request: &schedulev2.CreateScheduleRequest{
+				UserId:       userId,
+				Name:         "Test name",
+				Period:       durationpb.New(time.Hour * 7),
+				DurationDays: proto.Uint32(10),
+			},
+			expectedResponse: &schedulev2.Schedule{
+				Name:    "Test name",
+				Period:  durationpb.New(time.Hour * 7),
+				EndTime: timestamppb.New(time.Date(2025, time.January, 11, s.cfg.Schedule.EndDayHour, 0, 0, 0, time.UTC)),
+				Timetable: []*timestamppb.Timestamp{
+					timestamppb.New(time.Date(2025, time.January, 1, 8, 0, 0, 0, time.UTC)),
+					timestamppb.New(time.Date(2025, time.January, 1, 15, 0, 0, 0, time.UTC)),
+					timestamppb.New(time.Date(2025, time.January, 1, 22, 0, 0, 0, time.UTC)),
+				},
+			},
+		},
+		{
+			name: "without end date",
+			request: &schedulev2.CreateScheduleRequest{
+				UserId: userId,
+				Name:   "Test name",
+				Period: durationpb.New(time.Hour * 12),
+			},
+			expectedResponse: &schedulev2.Schedule{
+				Name:   "Test name",
+				Period: durationpb.New(time.Hour * 12),
+				Timetable: []*timestamppb.Timestamp{
+					timestamppb.New(time.Date(2025, time.January, 1, 8, 0, 0, 0, time.UTC)),
+					timestamppb.New(time.Date(2025, time.January, 1, 20, 0, 0, 0, time.UTC)),
+				},
+			},
+		},
+		{
+			name: "without period",
+			request: &schedulev2.CreateScheduleRequest{
+				UserId: userId,
+				Name:   "Test name",
+			},
+			expectedCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			if tc.bootstrap != nil {
+				tc.bootstrap()
+			}
+
+			resp, err := s.grpcClientV2.CreateSchedule(ctx, tc.request)
+
+			statusCode := status.Code(err)
+			rq.Equal(tc.expectedCode, statusCode)
+
+			if statusCode != codes.OK {
+				return
+			}
+
+			rq.NoError(err)
+
+			tc.expectedResponse.Id = resp.GetId()
+			rq.True(proto.Equal(tc.expectedResponse, resp), "expected: %v\n actual: %v", tc.expectedResponse, resp)
+		})
+	}
+}
+
+func (s *Suite) TestListSchedulesGRPCV2() {
+	const (
+		userId = 1000000000000000
+	)
+
+	rq := s.Require()
+	ctx := context.Background()
+
+	err := dbtest.MigrateFromFile(s.db, "testdata/list_schedules.sql")
+	rq.NoError(err)
+
+	testCases := []struct {
+		name         string
+		bootstrap    func()
+		request      *schedulev2.ListSchedulesRequest
+		expectedIds  []int32
+		expectedCode codes.Code
+	}{
+		{
+			name: "active by end time",
+			request: &schedulev2.ListSchedulesRequest{
+				UserId:    userId,
+				Status:    schedulev2.ScheduleStatus_SCHEDULE_STATUS_ACTIVE,
+				SortField: schedulev2.ScheduleSortField_SCHEDULE_SORT_FIELD_END_TIME,
+			},
+			expectedIds: []int32{1, 2, 3},
+		},
+		{
+			name: "end time range",
+			request: &schedulev2.ListSchedulesRequest{
+				UserId:      userId,
+				EndTimeFrom: timestamppb.New(time.Date(2025, time.January, 2, 0, 0, 0, 0, time.UTC)),
+				EndTimeTo:   timestamppb.New(time.Date(2025, time.January, 31, 0, 0, 0, 0, time.UTC)),
+			},
+			expectedIds: []int32{2},
+		},
+		{
+			name: "invalid range",
+			request: &schedulev2.ListSchedulesRequest{
+				UserId:      userId,
+				EndTimeFrom: timestamppb.New(time.Date(2025, time.January, 31, 0, 0, 0, 0, time.UTC)),
+				EndTimeTo:   timestamppb.New(time.Date(2025, time.January, 2, 0, 0, 0, 0, time.UTC)),
+			},
+			expectedCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			if tc.bootstrap != nil {
+				tc.bootstrap()
+			}
+
+			resp, err := s.grpcClientV2.ListSchedules(ctx, tc.request)
+
+			statusCode := status.Code(err)
+			rq.Equal(tc.expectedCode, statusCode)
+
+			if statusCode != codes.OK {
+				return
+			}
+
+			rq.NoError(err)
+
+			ids := make([]int32, len(resp.GetSchedules()))
+			for i, schedule := range resp.GetSchedules() {
+				ids[i] = schedule.GetId()
+			}
+
+			rq.Equal(tc.expectedIds, ids)
+		})
+	}
+}
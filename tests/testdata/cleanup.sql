@@ -1 +1,4 @@
-DELETE FROM schedule;
\ No newline at end of file
+DELETE FROM schedule;
+TRUNCATE TABLE schedule_audit;
+TRUNCATE TABLE user_settings;
+TRUNCATE TABLE schedule_intake;
\ No newline at end of file
@@ -0,0 +1,7 @@
+SET @minute = 60000000000;
+
+INSERT INTO schedule (id, user_id, name, end_at, period) VALUES (1, 1000000000000000, 'Test list_schedules Aspirin',     '2025-01-01', @minute * 60);
+INSERT INTO schedule (id, user_id, name, end_at, period) VALUES (2, 1000000000000000, 'Test list_schedules Paracetamol', '2025-01-10', @minute * 60 * 2);
+INSERT INTO schedule (id, user_id, name, end_at, period) VALUES (3, 1000000000000000, 'Test list_schedules Ibuprofen',   NULL,         @minute * 60 * 5);
+INSERT INTO schedule (id, user_id, name, end_at, period) VALUES (4, 1000000000000000, 'Test list_schedules expired',     '2024-12-31', @minute * 60);
+INSERT INTO schedule (id, user_id, name, end_at, period) VALUES (5, 1000000000000001, 'Test list_schedules other user',  NULL,         @minute * 60);
@@ -0,0 +1,3 @@
+SET @minute = 60000000000;
+
+INSERT INTO schedule (id, user_id, name, end_at, period) VALUES (1, 1000000000000000, 'Test user_schedules name', '2025-01-01', @minute * 120);
@@ -0,0 +1,96 @@
+package tests
+
+import (
+	"context"
+	"net/http"
+	"schedule/internal/util"
+	schedulev2 "schedule/pkg/grpc/v2"
+	"schedule/pkg/rest"
+)
+
+func (s *Suite) TestTimesPerDayHTTP() {
+	const (
+		userId = 1000000000000008
+	)
+
+	rq := s.Require()
+	ctx := context.Background()
+
+	created, err := s.httpClient.CreateUserScheduleWithResponse(ctx, userId, rest.CreateUserScheduleRequest{
+		Name:        "Test times per day",
+		TimesPerDay: util.Ptr(3),
+	})
+	rq.NoError(err)
+	rq.Equal(http.StatusCreated, created.StatusCode(), string(created.Body))
+	rq.Nil(created.JSON201.Warnings)
+
+	scheduleId := created.JSON201.Id
+
+	schedule, err := s.httpClient.GetUserScheduleWithResponse(ctx, userId, scheduleId, &rest.GetUserScheduleParams{})
+	rq.NoError(err)
+	rq.Equal(http.StatusOK, schedule.StatusCode(), string(schedule.Body))
+	rq.Equal(util.Ptr(3), schedule.JSON200.TimesPerDay)
+	rq.Equal("7h0m0s", schedule.JSON200.Period)
+	rq.Nil(schedule.JSON200.Warnings)
+	rq.Equal([]string{"08:00:00", "15:00:00", "22:00:00"}, schedule.JSON200.Timetable)
+
+	preview, err := s.httpClient.PreviewUserScheduleWithResponse(ctx, userId, &rest.PreviewUserScheduleParams{}, rest.CreateUserScheduleRequest{
+		Name:        "Test times per day",
+		TimesPerDay: util.Ptr(20),
+	})
+	rq.NoError(err)
+	rq.Equal(http.StatusOK, preview.StatusCode(), string(preview.Body))
+	rq.Equal(util.Ptr([]rest.ScheduleWarning{rest.ShortInterval}), preview.JSON200.Warnings)
+	rq.Len(preview.JSON200.Days[0].Timetable, 20)
+
+	warned, err := s.httpClient.CreateUserScheduleWithResponse(ctx, userId, rest.CreateUserScheduleRequest{
+		Name:        "Test times per day",
+		TimesPerDay: util.Ptr(20),
+	})
+	rq.NoError(err)
+	rq.Equal(http.StatusCreated, warned.StatusCode(), string(warned.Body))
+	rq.Equal(util.Ptr([]rest.ScheduleWarning{rest.ShortInterval}), warned.JSON201.Warnings)
+
+	invalid, err := s.httpClient.CreateUserScheduleWithResponse(ctx, userId, rest.CreateUserScheduleRequest{
+		Name:        "Test times per day",
+		Period:      "5h",
+		TimesPerDay: util.Ptr(3),
+	})
+	rq.NoError(err)
+	rq.Equal(http.StatusBadRequest, invalid.StatusCode(), string(invalid.Body))
+
+	updated, err := s.httpClient.UpdateUserScheduleWithResponse(ctx, userId, scheduleId, &rest.UpdateUserScheduleParams{}, rest.UpdateScheduleRequest{
+		Period: util.Ptr("5h"),
+	})
+	rq.NoError(err)
+	rq.Equal(http.StatusOK, updated.StatusCode(), string(updated.Body))
+	rq.Nil(updated.JSON200.TimesPerDay)
+	rq.Equal([]string{"08:00:00", "13:00:00", "18:00:00"}, updated.JSON200.Timetable)
+}
+
+func (s *Suite) TestTimesPerDayGRPCV2() {
+	const (
+		userId = 1000000000000008
+	)
+
+	rq := s.Require()
+	ctx := context.Background()
+
+	created, err := s.grpcClientV2.CreateSchedule(ctx, &schedulev2.CreateScheduleRequest{
+		UserId:      userId,
+		Name:        "Test times per day",
+		TimesPerDay: 2,
+	})
+	rq.NoError(err)
+	rq.Equal(uint32(2), created.GetTimesPerDay())
+	rq.Empty(created.GetWarnings())
+	rq.Len(created.GetTimetable(), 2)
+
+	created, err = s.grpcClientV2.CreateSchedule(ctx, &schedulev2.CreateScheduleRequest{
+		UserId:      userId,
+		Name:        "Test times per day",
+		TimesPerDay: 20,
+	})
+	rq.NoError(err)
+	rq.Equal([]schedulev2.ScheduleWarning{schedulev2.ScheduleWarning_SCHEDULE_WARNING_SHORT_INTERVAL}, created.GetWarnings())
+}
@@ -0,0 +1,204 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"schedule/internal/domain/entity"
+	"schedule/internal/util"
+	"schedule/pkg/dbtest"
+	"schedule/pkg/errcodes"
+	"schedule/pkg/rest"
+	"time"
+)
+
+func (s *Suite) TestCreateUserScheduleHTTP() {
+	const (
+		userId = 1000000000000000
+	)
+
+	rq := s.Require()
+	ctx := context.Background()
+
+	resp, err := s.httpClient.CreateUserScheduleWithResponse(ctx, userId, rest.CreateUserScheduleRequest{
+		Name:     "Test name",
+		Duration: 10,
+		Period:   "12h",
+	})
+	rq.NoError(err)
+	rq.Equal(http.StatusCreated, resp.StatusCode(), string(resp.Body))
+
+	var data entity.Schedule
+	err = s.db.GetContext(ctx, &data, "SELECT * FROM schedule WHERE id = ?", resp.JSON201.Id)
+	rq.NoError(err)
+
+	rq.EqualValues(userId, data.UserId)
+	rq.EqualValues("Test name", data.Name)
+	rq.Equal(fmt.Sprintf("/v1/users/%d/schedules/%d", userId, resp.JSON201.Id), resp.HTTPResponse.Header.Get("Location"))
+}
+
+func (s *Suite) TestUserScheduleHTTP() {
+	const (
+		userId     = 1000000000000000
+		scheduleId = 1
+	)
+
+	rq := s.Require()
+	ctx := context.Background()
+
+	testCases := []struct {
+		name             string
+		request          func() (int, *rest.ScheduleResponse, *rest.ErrorResponse, error)
+		expectedResponse *rest.ScheduleResponse
+		expectedStatus   int
+		expectedError    string
+	}{
+		{
+			name: "get",
+			request: func() (int, *rest.ScheduleResponse, *rest.ErrorResponse, error) {
+				resp, err := s.httpClient.GetUserScheduleWithResponse(ctx, userId, scheduleId, &rest.GetUserScheduleParams{})
+				if err != nil {
+					return 0, nil, nil, err
+				}
+				return resp.StatusCode(), resp.JSON200, resp.ApplicationproblemJSON404, nil
+			},
+			expectedResponse: &rest.ScheduleResponse{
+				Id:     scheduleId,
+				Name:   "Test user_schedules name",
+				EndAt:  util.Ptr(time.Date(2025, time.January, 1, s.cfg.Schedule.EndDayHour, 0, 0, 0, time.UTC).Format(time.RFC3339)),
+				Period: (time.Minute * 120).String(),
+				Timetable: []string{
+					"08:00:00",
+					"10:00:00",
+					"12:00:00",
+					"14:00:00",
+					"16:00:00",
+					"18:00:00",
+					"20:00:00",
+					"22:00:00",
+				},
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "patch",
+			request: func() (int, *rest.ScheduleResponse, *rest.ErrorResponse, error) {
+				resp, err := s.httpClient.UpdateUserScheduleWithResponse(ctx, userId, scheduleId, &rest.UpdateUserScheduleParams{}, rest.UpdateScheduleRequest{
+					Name:   util.Ptr("Updated name"),
+					Period: util.Ptr("4h"),
+				})
+				if err != nil {
+					return 0, nil, nil, err
+				}
+				return resp.StatusCode(), resp.JSON200, resp.ApplicationproblemJSON404, nil
+			},
+			expectedResponse: &rest.ScheduleResponse{
+				Id:     scheduleId,
+				Name:   "Updated name",
+				EndAt:  util.Ptr(time.Date(2025, time.January, 1, s.cfg.Schedule.EndDayHour, 0, 0, 0, time.UTC).Format(time.RFC3339)),
+				Period: (time.Hour * 4).String(),
+				Timetable: []string{
+					"08:00:00",
+					"12:00:00",
+					"16:00:00",
+					"20:00:00",
+				},
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "patch invalid period",
+			request: func() (int, *rest.ScheduleResponse, *rest.ErrorResponse, error) {
+				resp, err := s.httpClient.UpdateUserScheduleWithResponse(ctx, userId, scheduleId, &rest.UpdateUserScheduleParams{}, rest.UpdateScheduleRequest{
+					Period: util.Ptr("1m"),
+				})
+				if err != nil {
+					return 0, nil, nil, err
+				}
+				return resp.StatusCode(), resp.JSON200, resp.ApplicationproblemJSON400, nil
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedError:  errcodes.Validation.String(),
+		},
+		{
+			name: "get other user",
+			request: func() (int, *rest.ScheduleResponse, *rest.ErrorResponse, error) {
+				resp, err := s.httpClient.GetUserScheduleWithResponse(ctx, userId+1, scheduleId, &rest.GetUserScheduleParams{})
+				if err != nil {
+					return 0, nil, nil, err
+				}
+				return resp.StatusCode(), resp.JSON200, resp.ApplicationproblemJSON404, nil
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedError:  errcodes.NotFound.String(),
+		},
+	}
+
+	err := dbtest.MigrateFromFile(s.db, "testdata/user_schedules.sql")
+	rq.NoError(err)
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			statusCode, resp, errResp, err := tc.request()
+			rq.NoError(err)
+
+			rq.Equal(tc.expectedStatus, statusCode)
+
+			switch statusCode {
+			case http.StatusOK:
+				rq.Equal(tc.expectedResponse, resp)
+			case http.StatusBadRequest, http.StatusNotFound:
+				rq.Equal(tc.expectedError, errResp.Error)
+			default:
+				rq.Errorf(errors.New("unexpected status code"), "Code: %d", statusCode)
+			}
+		})
+	}
+}
+
+func (s *Suite) TestDeleteUserScheduleHTTP() {
+	const (
+		userId     = 1000000000000000
+		scheduleId = 1
+	)
+
+	rq := s.Require()
+	ctx := context.Background()
+
+	err := dbtest.MigrateFromFile(s.db, "testdata/user_schedules.sql")
+	rq.NoError(err)
+
+	resp, err := s.httpClient.DeleteUserScheduleWithResponse(ctx, userId, scheduleId)
+	rq.NoError(err)
+	rq.Equal(http.StatusNoContent, resp.StatusCode())
+
+	var count int
+	err = s.db.GetContext(ctx, &count, "SELECT COUNT(*) FROM schedule WHERE id = ?", scheduleId)
+	rq.NoError(err)
+	rq.Zero(count)
+
+	resp, err = s.httpClient.DeleteUserScheduleWithResponse(ctx, userId, scheduleId)
+	rq.NoError(err)
+	rq.Equal(http.StatusNotFound, resp.StatusCode())
+	rq.Equal(errcodes.NotFound.String(), resp.ApplicationproblemJSON404.Error)
+}
+
+func (s *Suite) TestGetUserNextTakingsHTTP() {
+	const (
+		userId = 1000000000000000
+	)
+
+	rq := s.Require()
+	ctx := context.Background()
+
+	resp, err := s.httpClient.GetUserNextTakingsWithResponse(ctx, userId, &rest.GetUserNextTakingsParams{})
+	rq.NoError(err)
+	rq.Equal(http.StatusOK, resp.StatusCode())
+
+	legacy, err := s.httpClient.GetNextTakingWithResponse(ctx, &rest.GetNextTakingParams{UserId: userId})
+	rq.NoError(err)
+	rq.Equal(http.StatusOK, legacy.StatusCode())
+
+	rq.Equal(legacy.JSON200, resp.JSON200)
+}